@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// dataSourceAccountSettings reports the account settings available via DescribeAccountSettings and whether the
+// account's edition supports capacity pricing at all. QuickSight does not expose an API reporting anonymous
+// (embedded) session usage or session capacity; that is only available through CloudWatch metrics and the console.
+// @SDKDataSource("aws_quicksight_account_settings", name="Account Settings")
+func dataSourceAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccountSettingsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"account_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrAWSAccountID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"capacity_pricing_supported": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"default_namespace": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default_namespace_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"edition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"notification_email": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"public_sharing_enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"termination_protection_enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceAccountSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	awsClient := meta.(*conns.AWSClient)
+	conn := awsClient.QuickSightClient(ctx)
+
+	awsAccountID := awsClient.AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+
+	settings, err := findAccountSettingsByID(ctx, conn, awsAccountID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight Account Settings (%s): %s", awsAccountID, err)
+	}
+
+	d.SetId(awsAccountID)
+	d.Set("account_name", settings.AccountName)
+	d.Set(names.AttrAWSAccountID, awsAccountID)
+	d.Set("capacity_pricing_supported", editionSupportsCapacityPricing(settings.Edition))
+	d.Set("default_namespace", settings.DefaultNamespace)
+	if v := aws.ToString(settings.DefaultNamespace); v != "" {
+		d.Set("default_namespace_arn", namespaceARN(awsClient.Partition, awsClient.Region, awsAccountID, v))
+	} else {
+		d.Set("default_namespace_arn", nil)
+	}
+	d.Set("edition", settings.Edition)
+	d.Set("notification_email", settings.NotificationEmail)
+	d.Set("public_sharing_enabled", settings.PublicSharingEnabled)
+	d.Set("termination_protection_enabled", settings.TerminationProtectionEnabled)
+
+	return diags
+}
+
+// editionSupportsCapacityPricing returns whether session capacity pricing can be enabled for the specified edition.
+// Capacity pricing is only offered for Enterprise edition accounts.
+func editionSupportsCapacityPricing(edition awstypes.Edition) bool {
+	switch edition {
+	case awstypes.EditionEnterprise, awstypes.EditionEnterpriseAndQ:
+		return true
+	default:
+		return false
+	}
+}
+
+func findAccountSettingsByID(ctx context.Context, conn *quicksight.Client, id string) (*awstypes.AccountSettings, error) {
+	input := &quicksight.DescribeAccountSettingsInput{
+		AwsAccountId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAccountSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccountSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccountSettings, nil
+}
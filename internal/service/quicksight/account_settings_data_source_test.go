@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEditionSupportsCapacityPricing(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[awstypes.Edition]bool{
+		awstypes.EditionEnterprise:     true,
+		awstypes.EditionEnterpriseAndQ: true,
+		awstypes.EditionStandard:       false,
+		"":                             false,
+	}
+
+	for edition, want := range testCases {
+		if got := tfquicksight.EditionSupportsCapacityPricing(edition); got != want {
+			t.Errorf("EditionSupportsCapacityPricing(%q) = %t, want %t", edition, got, want)
+		}
+	}
+}
+
+func TestAccQuickSightAccountSettingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_quicksight_account_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "account_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "capacity_pricing_supported"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_namespace"),
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "default_namespace_arn", "quicksight", regexache.MustCompile(`namespace/.+`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "edition"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "public_sharing_enabled"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "termination_protection_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAccountSettingsDataSource_awsAccountID(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_quicksight_account_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsDataSourceConfig_awsAccountID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAWSAccountID, "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "edition"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountSettingsDataSourceConfig_basic = `
+data "aws_quicksight_account_settings" "test" {}
+`
+
+const testAccAccountSettingsDataSourceConfig_awsAccountID = `
+data "aws_caller_identity" "current" {}
+
+data "aws_quicksight_account_settings" "test" {
+  aws_account_id = data.aws_caller_identity.current.account_id
+}
+`
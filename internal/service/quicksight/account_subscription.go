@@ -5,13 +5,22 @@ package quicksight
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	dstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	ssoadmintypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -29,14 +38,26 @@ func resourceAccountSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountSubscriptionCreate,
 		ReadWithoutTimeout:   resourceAccountSubscriptionRead,
+		UpdateWithoutTimeout: resourceAccountSubscriptionUpdate,
 		DeleteWithoutTimeout: resourceAccountSubscriptionDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccountSubscriptionImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			accountSubscriptionActiveDirectoryCustomizeDiff,
+			accountSubscriptionGroupsCustomizeDiff,
+			accountSubscriptionValidationOnlyCustomizeDiff,
+		),
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"account_name": {
@@ -49,16 +70,16 @@ func resourceAccountSubscription() *schema.Resource {
 					Computed: true,
 				},
 				"active_directory_name": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
 				},
 				"admin_group": {
 					Type:     schema.TypeList,
 					Optional: true,
 					MinItems: 1,
 					Elem:     &schema.Schema{Type: schema.TypeString},
-					ForceNew: true,
 				},
 				"authentication_method": {
 					Type:             schema.TypeString,
@@ -71,7 +92,6 @@ func resourceAccountSubscription() *schema.Resource {
 					Optional: true,
 					MinItems: 1,
 					Elem:     &schema.Schema{Type: schema.TypeString},
-					ForceNew: true,
 				},
 				names.AttrAWSAccountID: {
 					Type:         schema.TypeString,
@@ -81,14 +101,20 @@ func resourceAccountSubscription() *schema.Resource {
 					ValidateFunc: verify.ValidAccountID,
 				},
 				"contact_number": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
 				},
 				"directory_id": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
+				},
+				"directory_type": {
 					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Computed: true,
 				},
 				"edition": {
 					Type:             schema.TypeString,
@@ -97,41 +123,60 @@ func resourceAccountSubscription() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.Edition](),
 				},
 				"email_address": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
 				},
 				"first_name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
+				},
+				"iam_identity_center_application_arn": {
 					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Computed: true,
 				},
 				"iam_identity_center_instance_arn": {
 					Type:     schema.TypeString,
 					Optional: true,
 					ForceNew: true,
 				},
+				"iam_user": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
 				"last_name": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
 				},
 				"notification_email": {
 					Type:     schema.TypeString,
 					Required: true,
-					ForceNew: true,
 				},
 				"reader_group": {
 					Type:     schema.TypeList,
 					Optional: true,
-					ForceNew: true,
 					MinItems: 1,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 				"realm": {
-					Type:     schema.TypeString,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: accountSubscriptionSignUpOnlyDiffSuppress,
+				},
+				"termination_protection_enabled": {
+					Type:     schema.TypeBool,
 					Optional: true,
-					ForceNew: true,
+				},
+				"validation_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
 				},
 			}
 		},
@@ -199,25 +244,87 @@ func resourceAccountSubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 		input.Realm = aws.String(v.(string))
 	}
 
-	_, err := conn.CreateAccountSubscription(ctx, input)
+	// A sign-up against a missing or unsupported directory fails only after the account has been committed to Active Directory authentication.
+	if input.AuthenticationMethod == awstypes.AuthenticationMethodOptionActiveDirectory && input.DirectoryId != nil {
+		if err := checkAccountSubscriptionDirectory(ctx, meta.(*conns.AWSClient).DSClient(ctx), aws.ToString(input.DirectoryId)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating QuickSight Account Subscription (%s): %s", accountName, err)
+		}
+	}
+
+	if d.Get("validation_only").(bool) {
+		log.Printf("[INFO] Skipping QuickSight Account Subscription (%s) create: validation_only is set", accountName)
+		d.SetId(accountSubscriptionValidationOnlyID(awsAccountID))
+
+		return diags
+	}
+
+	output, err := createAccountSubscription(ctx, conn, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating QuickSight Account Subscription (%s): %s", accountName, err)
 	}
 
+	// The sign-up response is only returned by CreateAccountSubscription, so Read leaves the value in state.
+	if output != nil && output.SignupResponse != nil {
+		d.Set("iam_user", output.SignupResponse.IAMUser)
+	}
+
 	d.SetId(awsAccountID)
 
-	if _, err := waitAccountSubscriptionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	identityCenter := input.AuthenticationMethod == awstypes.AuthenticationMethodOptionIamIdentityCenter
+	out, err := waitAccountSubscriptionCreated(ctx, conn, d.Id(), identityCenter, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for QuickSight Account Subscription (%s) create: %s", d.Id(), err)
 	}
 
+	applicationARN, err := findIdentityCenterApplicationARN(ctx, meta.(*conns.AWSClient).SSOAdminClient(ctx), aws.ToString(out.IAMIdentityCenterInstanceArn), d.Id())
+
+	if err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading QuickSight Account Subscription (%s) IAM Identity Center application: %s", d.Id(), err)
+	}
+
+	d.Set("iam_identity_center_application_arn", applicationARN)
+
+	// QuickSight may enable termination protection on sign-up. It's only changed when explicitly configured.
+	if v := d.GetRawConfig().GetAttr("termination_protection_enabled"); v.IsKnown() && !v.IsNull() {
+		if err := updateAccountSettings(ctx, conn, d.Id(), nil, aws.Bool(v.True())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Account Subscription (%s) termination protection: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceAccountSubscriptionRead(ctx, d, meta)...)
 }
 
+// createAccountSubscription signs the account up for QuickSight, or adopts a sign-up that is already in progress.
+// A previous create may have been interrupted after the sign-up started. Re-issuing the create would conflict, so the
+// pending sign-up is left for the caller to wait on and no output is returned.
+func createAccountSubscription(ctx context.Context, conn *quicksight.Client, input *quicksight.CreateAccountSubscriptionInput) (*quicksight.CreateAccountSubscriptionOutput, error) {
+	awsAccountID := aws.ToString(input.AwsAccountId)
+	inProgress, err := accountSubscriptionSignupInProgress(statusAccountSubscription(ctx, conn, awsAccountID))
+
+	if err != nil {
+		return nil, fmt.Errorf("reading sign-up status: %w", err)
+	}
+
+	if inProgress {
+		log.Printf("[INFO] Adopting in-progress QuickSight Account Subscription (%s)", awsAccountID)
+		return nil, nil
+	}
+
+	return conn.CreateAccountSubscription(ctx, input)
+}
+
 func resourceAccountSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
 
+	// No subscription exists for a validation-only create; the sentinel ID still carries the account ID.
+	if accountSubscriptionIsValidationOnly(d.Id()) {
+		d.Set(names.AttrAWSAccountID, strings.TrimPrefix(d.Id(), accountSubscriptionValidationOnlyIDPrefix))
+		return diags
+	}
+
 	out, err := findAccountSubscriptionByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -232,17 +339,202 @@ func resourceAccountSubscriptionRead(ctx context.Context, d *schema.ResourceData
 
 	d.Set("account_name", out.AccountName)
 	d.Set("account_subscription_status", out.AccountSubscriptionStatus)
+	d.Set(names.AttrAWSAccountID, d.Id())
+	d.Set("directory_type", accountDirectoryType(out))
 	d.Set("edition", out.Edition)
 	d.Set("iam_identity_center_instance_arn", out.IAMIdentityCenterInstanceArn)
 	d.Set("notification_email", out.NotificationEmail)
 
+	settings, err := findAccountSettingsByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight Account Subscription (%s) settings: %s", d.Id(), err)
+	}
+
+	// Termination protection is only read back once it is managed, so that the protection QuickSight enables
+	// at sign-up doesn't show up as drift.
+	if v := d.GetRawState(); !v.IsNull() && !v.GetAttr("termination_protection_enabled").IsNull() {
+		d.Set("termination_protection_enabled", settings.TerminationProtectionEnabled)
+	}
+
+	// The IAM Identity Center application ARN is looked up on create and import only, and kept in state.
+	if aws.ToString(out.IAMIdentityCenterInstanceArn) == "" {
+		d.Set("iam_identity_center_application_arn", nil)
+	}
+
 	return diags
 }
 
+// resourceAccountSubscriptionImport sets the arguments that Read doesn't manage, so that a subscription
+// created outside of Terraform has a clean plan after import.
+func resourceAccountSubscriptionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	out, err := findAccountSubscriptionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading QuickSight Account Subscription (%s): %w", d.Id(), err)
+	}
+
+	method := awstypes.AuthenticationMethodOption(aws.ToString(out.AuthenticationType))
+	d.Set("authentication_method", method)
+	d.Set("validation_only", false)
+
+	applicationARN, err := findIdentityCenterApplicationARN(ctx, meta.(*conns.AWSClient).SSOAdminClient(ctx), aws.ToString(out.IAMIdentityCenterInstanceArn), d.Id())
+
+	if err != nil {
+		log.Printf("[WARN] Reading QuickSight Account Subscription (%s) IAM Identity Center application: %s", d.Id(), err)
+	}
+
+	d.Set("iam_identity_center_application_arn", applicationARN)
+
+	// The groups are only read on import so that role memberships managed elsewhere don't show up as drift.
+	if authenticationMethodSupportsRoleMemberships(method) {
+		for key, role := range accountSubscriptionGroupRoles {
+			members, err := findRoleMemberships(ctx, conn, &quicksight.ListRoleMembershipsInput{
+				AwsAccountId: aws.String(d.Id()),
+				Namespace:    aws.String(defaultGroupNamespace),
+				Role:         role,
+			})
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return nil, fmt.Errorf("reading QuickSight Account Subscription (%s) %s: %w", d.Id(), key, err)
+			default:
+				slices.Sort(members)
+				d.Set(key, members)
+			}
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// accountSubscriptionSignUpOnlyDiffSuppress ignores sign-up arguments that can't be read back when they are added
+// to an existing subscription, for example after import. They only take effect at sign-up, so adding them would
+// otherwise replace the subscription.
+func accountSubscriptionSignUpOnlyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func resourceAccountSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	if accountSubscriptionIsValidationOnly(d.Id()) {
+		return diags
+	}
+
+	if d.HasChanges("notification_email", "termination_protection_enabled") {
+		var email *string
+		if d.HasChange("notification_email") {
+			email = aws.String(d.Get("notification_email").(string))
+		}
+		var terminationProtectionEnabled *bool
+		// Removing the argument stops managing termination protection and leaves the current setting.
+		if v := d.GetRawConfig().GetAttr("termination_protection_enabled"); d.HasChange("termination_protection_enabled") && !v.IsNull() {
+			terminationProtectionEnabled = aws.Bool(d.Get("termination_protection_enabled").(bool))
+		}
+
+		if err := updateAccountSettings(ctx, conn, d.Id(), email, terminationProtectionEnabled); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Account Subscription (%s) settings: %s", d.Id(), err)
+		}
+	}
+
+	for key, role := range accountSubscriptionGroupRoles {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		o, n := d.GetChange(key)
+		os, ns := flex.ExpandStringValueList(o.([]interface{})), flex.ExpandStringValueList(n.([]interface{}))
+
+		for _, v := range os {
+			if slices.Contains(ns, v) {
+				continue
+			}
+
+			if err := deleteRoleMembership(ctx, conn, d.Id(), role, v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating QuickSight Account Subscription (%s) %s: %s", d.Id(), key, err)
+			}
+		}
+
+		for _, v := range ns {
+			if slices.Contains(os, v) {
+				continue
+			}
+
+			if err := createRoleMembership(ctx, conn, d.Id(), role, v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating QuickSight Account Subscription (%s) %s: %s", d.Id(), key, err)
+			}
+		}
+	}
+
+	return append(diags, resourceAccountSubscriptionRead(ctx, d, meta)...)
+}
+
+// updateAccountSettings changes the account's notification email and termination protection. A nil value keeps the
+// current setting. UpdateAccountSettings also sets the default namespace, so its current value is carried over.
+// No request is sent if the settings already match.
+func updateAccountSettings(ctx context.Context, conn *quicksight.Client, awsAccountID string, email *string, terminationProtectionEnabled *bool) error {
+	settings, err := findAccountSettingsByID(ctx, conn, awsAccountID)
+
+	if err != nil {
+		return fmt.Errorf("reading QuickSight Account Settings (%s): %w", awsAccountID, err)
+	}
+
+	input := &quicksight.UpdateAccountSettingsInput{
+		AwsAccountId:                 aws.String(awsAccountID),
+		DefaultNamespace:             settings.DefaultNamespace,
+		NotificationEmail:            settings.NotificationEmail,
+		TerminationProtectionEnabled: settings.TerminationProtectionEnabled,
+	}
+
+	if email != nil {
+		input.NotificationEmail = email
+	}
+
+	if terminationProtectionEnabled != nil {
+		input.TerminationProtectionEnabled = aws.ToBool(terminationProtectionEnabled)
+	}
+
+	if aws.ToString(input.NotificationEmail) == aws.ToString(settings.NotificationEmail) && input.TerminationProtectionEnabled == settings.TerminationProtectionEnabled {
+		return nil
+	}
+
+	_, err = conn.UpdateAccountSettings(ctx, input)
+
+	return err
+}
+
+// isTerminationProtectionError returns whether err reports that the subscription can't be deleted because
+// termination protection is enabled. The message may start the sentence, so both capitalizations are matched.
+func isTerminationProtectionError(err error) bool {
+	return errs.IsAErrorMessageContains[*awstypes.PreconditionNotMetException](err, "termination protection") ||
+		errs.IsAErrorMessageContains[*awstypes.PreconditionNotMetException](err, "Termination protection")
+}
+
 func resourceAccountSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
 
+	if accountSubscriptionIsValidationOnly(d.Id()) {
+		return diags
+	}
+
+	if !d.Get("termination_protection_enabled").(bool) {
+		err := updateAccountSettings(ctx, conn, d.Id(), nil, aws.Bool(false))
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling QuickSight Account Subscription (%s) termination protection: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting QuickSight Account Subscription: %s", d.Id())
 	_, err := conn.DeleteAccountSubscription(ctx, &quicksight.DeleteAccountSubscriptionInput{
 		AwsAccountId: aws.String(d.Id()),
@@ -252,6 +544,10 @@ func resourceAccountSubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 		return diags
 	}
 
+	if isTerminationProtectionError(err) {
+		return sdkdiag.AppendErrorf(diags, "deleting QuickSight Account Subscription (%s): termination protection is enabled. Set termination_protection_enabled to false and apply before destroying: %s", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting QuickSight Account Subscription (%s): %s", d.Id(), err)
 	}
@@ -263,22 +559,285 @@ func resourceAccountSubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
-// Not documented on AWS
+// accountSubscriptionValidationOnlyIDPrefix prefixes the sentinel ID of an account subscription
+// created with validation_only set, for which no subscription exists.
+const accountSubscriptionValidationOnlyIDPrefix = "validation-only-"
+
+func accountSubscriptionValidationOnlyID(awsAccountID string) string {
+	return accountSubscriptionValidationOnlyIDPrefix + awsAccountID
+}
+
+func accountSubscriptionIsValidationOnly(id string) bool {
+	return strings.HasPrefix(id, accountSubscriptionValidationOnlyIDPrefix)
+}
+
+// accountSubscriptionValidationOnlyCustomizeDiff replaces a validation-only resource with a real subscription when
+// validation_only is turned off. Turning it on for a real subscription is rejected: replacing the subscription would
+// delete it, which a dry run must never do.
+func accountSubscriptionValidationOnlyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("validation_only") {
+		return nil
+	}
+
+	if accountSubscriptionIsValidationOnly(d.Id()) {
+		if !d.Get("validation_only").(bool) {
+			return d.ForceNew("validation_only")
+		}
+
+		return nil
+	}
+
+	if d.Get("validation_only").(bool) {
+		return fmt.Errorf("validation_only can't be enabled for an existing QuickSight Account Subscription (%s). Remove the resource from state to stop managing the subscription without deleting it", d.Id())
+	}
+
+	return nil
+}
+
+// Directory types used by a QuickSight account to manage its users.
 const (
-	accountSubscriptionStatusCreated                 = "ACCOUNT_CREATED"
-	accountSubscriptionStatusOK                      = "OK"
-	accountSubscriptionStatusSignupAttemptInProgress = "SIGNUP_ATTEMPT_IN_PROGRESS"
-	accountSubscriptionStatusUnsuscribeInProgress    = "UNSUBSCRIBE_IN_PROGRESS"
-	accountSubscriptionStatusUnsuscribed             = "UNSUBSCRIBED"
+	accountDirectoryTypeActiveDirectory   = "ACTIVE_DIRECTORY"
+	accountDirectoryTypeIAMIdentityCenter = "IAM_IDENTITY_CENTER"
+	accountDirectoryTypeQuickSight        = "QUICKSIGHT"
 )
 
-func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.Client, id string, timeout time.Duration) (*awstypes.AccountInfo, error) {
+// accountDirectoryType returns the type of directory used by the account.
+// DescribeAccountSubscription doesn't return the directory type directly, so it is derived from the authentication type.
+func accountDirectoryType(apiObject *awstypes.AccountInfo) string {
+	switch awstypes.AuthenticationMethodOption(aws.ToString(apiObject.AuthenticationType)) {
+	case awstypes.AuthenticationMethodOptionActiveDirectory:
+		return accountDirectoryTypeActiveDirectory
+	case awstypes.AuthenticationMethodOptionIamIdentityCenter:
+		return accountDirectoryTypeIAMIdentityCenter
+	case awstypes.AuthenticationMethodOptionIamAndQuicksight, awstypes.AuthenticationMethodOptionIamOnly:
+		return accountDirectoryTypeQuickSight
+	default:
+		return ""
+	}
+}
+
+// accountSubscriptionActiveDirectoryAttributes are the arguments that are all required for, and only valid with,
+// the ACTIVE_DIRECTORY authentication method.
+var accountSubscriptionActiveDirectoryAttributes = []string{
+	"active_directory_name",
+	"directory_id",
+	"realm",
+}
+
+func accountSubscriptionActiveDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("authentication_method") {
+		return nil
+	}
+
+	var configured []string
+	for _, key := range accountSubscriptionActiveDirectoryAttributes {
+		// Unknown values will be set once known.
+		if v := d.GetRawConfig().GetAttr(key); !v.IsKnown() || !v.IsNull() {
+			configured = append(configured, key)
+		}
+	}
+
+	return validateAccountSubscriptionActiveDirectory(awstypes.AuthenticationMethodOption(d.Get("authentication_method").(string)), configured)
+}
+
+// validateAccountSubscriptionActiveDirectory returns an error if the configured Active Directory arguments
+// are incomplete for the ACTIVE_DIRECTORY authentication method, or are set for any other method.
+func validateAccountSubscriptionActiveDirectory(method awstypes.AuthenticationMethodOption, configured []string) error {
+	if method != awstypes.AuthenticationMethodOptionActiveDirectory {
+		if len(configured) > 0 {
+			return fmt.Errorf("%s can only be set when authentication_method is %s", strings.Join(configured, ", "), awstypes.AuthenticationMethodOptionActiveDirectory)
+		}
+
+		return nil
+	}
+
+	var missing []string
+	for _, key := range accountSubscriptionActiveDirectoryAttributes {
+		if !slices.Contains(configured, key) {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("authentication_method %s requires %s; missing: %s", method, strings.Join(accountSubscriptionActiveDirectoryAttributes, ", "), strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// accountSubscriptionGroupRoles maps the group arguments to the QuickSight role their members are assigned.
+var accountSubscriptionGroupRoles = map[string]awstypes.Role{
+	"admin_group":  awstypes.RoleAdmin,
+	"author_group": awstypes.RoleAuthor,
+	"reader_group": awstypes.RoleReader,
+}
+
+// accountSubscriptionGroupsCustomizeDiff forces replacement when the groups change on an account whose
+// authentication method doesn't support role memberships. Role memberships can only be managed after
+// sign-up for accounts using IAM Identity Center or Active Directory.
+func accountSubscriptionGroupsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if authenticationMethodSupportsRoleMemberships(awstypes.AuthenticationMethodOption(d.Get("authentication_method").(string))) {
+		return nil
+	}
+
+	for key := range accountSubscriptionGroupRoles {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func authenticationMethodSupportsRoleMemberships(method awstypes.AuthenticationMethodOption) bool {
+	switch method {
+	case awstypes.AuthenticationMethodOptionActiveDirectory, awstypes.AuthenticationMethodOptionIamIdentityCenter:
+		return true
+	default:
+		return false
+	}
+}
+
+func createRoleMembership(ctx context.Context, conn *quicksight.Client, awsAccountID string, role awstypes.Role, memberName string) error {
+	input := &quicksight.CreateRoleMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		MemberName:   aws.String(memberName),
+		Namespace:    aws.String(defaultGroupNamespace),
+		Role:         role,
+	}
+
+	_, err := conn.CreateRoleMembership(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceExistsException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("adding group (%s) to role (%s): %w", memberName, role, err)
+	}
+
+	return nil
+}
+
+func deleteRoleMembership(ctx context.Context, conn *quicksight.Client, awsAccountID string, role awstypes.Role, memberName string) error {
+	input := &quicksight.DeleteRoleMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		MemberName:   aws.String(memberName),
+		Namespace:    aws.String(defaultGroupNamespace),
+		Role:         role,
+	}
+
+	_, err := conn.DeleteRoleMembership(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing group (%s) from role (%s): %w", memberName, role, err)
+	}
+
+	return nil
+}
+
+// AccountSubscriptionStatus is the status of a QuickSight account subscription.
+// Not documented on AWS.
+type AccountSubscriptionStatus string
+
+const (
+	AccountSubscriptionStatusCreated                 AccountSubscriptionStatus = "ACCOUNT_CREATED"
+	AccountSubscriptionStatusOK                      AccountSubscriptionStatus = "OK"
+	AccountSubscriptionStatusSignupAttemptInProgress AccountSubscriptionStatus = "SIGNUP_ATTEMPT_IN_PROGRESS"
+	AccountSubscriptionStatusUnsuscribeInProgress    AccountSubscriptionStatus = "UNSUBSCRIBE_IN_PROGRESS"
+	AccountSubscriptionStatusUnsuscribed             AccountSubscriptionStatus = "UNSUBSCRIBED"
+)
+
+func (AccountSubscriptionStatus) Values() []AccountSubscriptionStatus {
+	return []AccountSubscriptionStatus{
+		AccountSubscriptionStatusCreated,
+		AccountSubscriptionStatusOK,
+		AccountSubscriptionStatusSignupAttemptInProgress,
+		AccountSubscriptionStatusUnsuscribeInProgress,
+		AccountSubscriptionStatusUnsuscribed,
+	}
+}
+
+// IsActive returns whether an account subscription in the specified status is ready for use.
+// ACCOUNT_CREATED can be reported before the account is usable, so only OK counts as active.
+func IsActive(status AccountSubscriptionStatus) bool {
+	return status == AccountSubscriptionStatusOK
+}
+
+// accountSubscriptionStatusPending is a synthetic status reported while waiting for a subscription to be created
+// when QuickSight reports a status that is neither known nor terminal. The statuses are not documented, so
+// unexpected ones are waited on rather than failing the create.
+const accountSubscriptionStatusPending AccountSubscriptionStatus = "PENDING"
+
+// isAccountSubscriptionStatusFailed returns whether a subscription in the specified status will not become active.
+func isAccountSubscriptionStatusFailed(status AccountSubscriptionStatus) bool {
+	switch status {
+	case AccountSubscriptionStatusUnsuscribeInProgress, AccountSubscriptionStatusUnsuscribed:
+		return true
+	default:
+		return false
+	}
+}
+
+// accountSubscriptionStatusIdentityCenterProvisioning is a synthetic status reported while an active
+// IAM Identity Center subscription has not yet been linked to its Identity Center instance.
+const accountSubscriptionStatusIdentityCenterProvisioning AccountSubscriptionStatus = "IAM_IDENTITY_CENTER_PROVISIONING"
+
+// envVarAccountSubscriptionPollDelay is the environment variable that sets the initial delay and minimum
+// interval, as a Go duration such as "30s", between DescribeAccountSubscription calls while waiting.
+// Slowing polling avoids throttling when many subscriptions are waited on concurrently, e.g. in CI.
+const envVarAccountSubscriptionPollDelay = "TF_AWS_QUICKSIGHT_ACCOUNT_SUBSCRIPTION_POLL_DELAY"
+
+// setAccountSubscriptionPollDelay applies any poll delay set in the environment to stateConf.
+// An unset or invalid value leaves the StateChangeConf defaults in place.
+func setAccountSubscriptionPollDelay(stateConf *retry.StateChangeConf) {
+	v := os.Getenv(envVarAccountSubscriptionPollDelay)
+	if v == "" {
+		return
+	}
+
+	delay, err := time.ParseDuration(v)
+
+	if err != nil || delay < 0 {
+		log.Printf("[WARN] Ignoring invalid %s value (%s)", envVarAccountSubscriptionPollDelay, v)
+		return
+	}
+
+	stateConf.Delay = delay
+	stateConf.MinTimeout = delay
+}
+
+// waitAccountSubscriptionCreated waits for the subscription to become active.
+// When identityCenter is set it additionally waits for the IAM Identity Center instance ARN to be populated.
+func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.Client, id string, identityCenter bool, timeout time.Duration) (*awstypes.AccountInfo, error) {
+	refresh := statusAccountSubscriptionCreating(statusAccountSubscription(ctx, conn, id))
+	if identityCenter {
+		refresh = statusAccountSubscriptionIdentityCenter(refresh)
+	}
+
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{accountSubscriptionStatusSignupAttemptInProgress},
-		Target:  []string{accountSubscriptionStatusCreated, accountSubscriptionStatusOK},
-		Refresh: statusAccountSubscription(ctx, conn, id),
-		Timeout: timeout,
+		Pending: enum.Slice(
+			AccountSubscriptionStatusSignupAttemptInProgress,
+			AccountSubscriptionStatusCreated,
+			accountSubscriptionStatusIdentityCenterProvisioning,
+			accountSubscriptionStatusPending,
+		),
+		Target:                    enum.Slice(AccountSubscriptionStatusOK),
+		Refresh:                   refresh,
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
 	}
+	setAccountSubscriptionPollDelay(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -291,11 +850,12 @@ func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.Client
 
 func waitAccountSubscriptionDeleted(ctx context.Context, conn *quicksight.Client, id string, timeout time.Duration) (*awstypes.AccountInfo, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{accountSubscriptionStatusCreated, accountSubscriptionStatusOK, accountSubscriptionStatusUnsuscribeInProgress},
+		Pending: enum.Slice(AccountSubscriptionStatusCreated, AccountSubscriptionStatusOK, AccountSubscriptionStatusUnsuscribeInProgress),
 		Target:  []string{},
 		Refresh: statusAccountSubscription(ctx, conn, id),
 		Timeout: timeout,
 	}
+	setAccountSubscriptionPollDelay(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -322,6 +882,61 @@ func statusAccountSubscription(ctx context.Context, conn *quicksight.Client, id
 	}
 }
 
+// accountSubscriptionSignupInProgress returns whether the account already has a sign-up in progress.
+// ACCOUNT_CREATED is reported after SIGNUP_ATTEMPT_IN_PROGRESS but before the account is usable, so it counts too.
+func accountSubscriptionSignupInProgress(refresh retry.StateRefreshFunc) (bool, error) {
+	_, status, err := refresh()
+
+	if err != nil {
+		return false, err
+	}
+
+	switch AccountSubscriptionStatus(status) {
+	case AccountSubscriptionStatusCreated, AccountSubscriptionStatusSignupAttemptInProgress:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// statusAccountSubscriptionCreating fails as soon as the subscription reaches a status from which it won't
+// become active, and reports unknown statuses as pending.
+func statusAccountSubscriptionCreating(refresh retry.StateRefreshFunc) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		outputRaw, status, err := refresh()
+
+		if err != nil || outputRaw == nil {
+			return outputRaw, status, err
+		}
+
+		switch v := AccountSubscriptionStatus(status); {
+		case v == AccountSubscriptionStatusSignupAttemptInProgress, v == AccountSubscriptionStatusCreated, v == AccountSubscriptionStatusOK:
+			return outputRaw, status, nil
+		case isAccountSubscriptionStatusFailed(v):
+			return outputRaw, status, fmt.Errorf("account sign-up failed with status %s", status)
+		default:
+			log.Printf("[DEBUG] Waiting on QuickSight Account Subscription with unexpected status: %s", status)
+			return outputRaw, string(accountSubscriptionStatusPending), nil
+		}
+	}
+}
+
+func statusAccountSubscriptionIdentityCenter(refresh retry.StateRefreshFunc) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		outputRaw, status, err := refresh()
+
+		if err != nil {
+			return outputRaw, status, err
+		}
+
+		if output, ok := outputRaw.(*awstypes.AccountInfo); ok && IsActive(AccountSubscriptionStatus(status)) && aws.ToString(output.IAMIdentityCenterInstanceArn) == "" {
+			return output, string(accountSubscriptionStatusIdentityCenterProvisioning), nil
+		}
+
+		return outputRaw, status, nil
+	}
+}
+
 func findAccountSubscriptionByID(ctx context.Context, conn *quicksight.Client, id string) (*awstypes.AccountInfo, error) {
 	input := &quicksight.DescribeAccountSubscriptionInput{
 		AwsAccountId: aws.String(id),
@@ -333,9 +948,9 @@ func findAccountSubscriptionByID(ctx context.Context, conn *quicksight.Client, i
 		return nil, err
 	}
 
-	if status := aws.ToString(output.AccountSubscriptionStatus); status == accountSubscriptionStatusUnsuscribed {
+	if status := AccountSubscriptionStatus(aws.ToString(output.AccountSubscriptionStatus)); status == AccountSubscriptionStatusUnsuscribed {
 		return nil, &retry.NotFoundError{
-			Message:     status,
+			Message:     string(status),
 			LastRequest: input,
 		}
 	}
@@ -363,3 +978,113 @@ func findAccountSubscription(ctx context.Context, conn *quicksight.Client, input
 
 	return output.AccountInfo, nil
 }
+
+// checkAccountSubscriptionDirectory returns an error if the specified directory does not exist
+// or cannot be used for QuickSight Active Directory authentication.
+func checkAccountSubscriptionDirectory(ctx context.Context, conn *directoryservice.Client, directoryID string) error {
+	directory, err := findDirectoryServiceDirectoryByID(ctx, conn, directoryID)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("directory_id: Directory Service Directory (%s) not found", directoryID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Directory Service Directory (%s): %w", directoryID, err)
+	}
+
+	switch directory.Type {
+	case dstypes.DirectoryTypeMicrosoftAd, dstypes.DirectoryTypeAdConnector:
+	default:
+		return fmt.Errorf("directory_id: Directory Service Directory (%s) is of type %s, expected %s or %s", directoryID, directory.Type, dstypes.DirectoryTypeMicrosoftAd, dstypes.DirectoryTypeAdConnector)
+	}
+
+	if stage := directory.Stage; stage != dstypes.DirectoryStageActive {
+		return fmt.Errorf("directory_id: Directory Service Directory (%s) is %s, expected %s", directoryID, stage, dstypes.DirectoryStageActive)
+	}
+
+	return nil
+}
+
+func findDirectoryServiceDirectoryByID(ctx context.Context, conn *directoryservice.Client, id string) (*dstypes.DirectoryDescription, error) {
+	input := &directoryservice.DescribeDirectoriesInput{
+		DirectoryIds: []string{id},
+	}
+
+	output, err := conn.DescribeDirectories(ctx, input)
+
+	if errs.IsA[*dstypes.EntityDoesNotExistException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.DirectoryDescriptions)
+}
+
+// identityCenterApplicationProviderQuickSight is the suffix of the IAM Identity Center application provider ARN for QuickSight.
+const identityCenterApplicationProviderQuickSight = "applicationProvider/quicksight"
+
+func isQuickSightIdentityCenterApplication(apiObject ssoadmintypes.Application) bool {
+	return strings.HasSuffix(aws.ToString(apiObject.ApplicationProviderArn), identityCenterApplicationProviderQuickSight)
+}
+
+// findIdentityCenterApplicationARN returns the ARN of the IAM Identity Center application that
+// CreateAccountSubscription provisions. QuickSight doesn't return it, so it's looked up in the instance.
+// No ARN is returned if the subscription doesn't use IAM Identity Center, if the application can't be found,
+// or if access is denied because the instance is managed from another account.
+func findIdentityCenterApplicationARN(ctx context.Context, conn *ssoadmin.Client, instanceARN, awsAccountID string) (*string, error) {
+	if instanceARN == "" {
+		return nil, nil
+	}
+
+	application, err := findIdentityCenterApplication(ctx, conn, instanceARN, awsAccountID)
+
+	switch {
+	case tfresource.NotFound(err):
+		return nil, nil
+	case errs.IsA[*ssoadmintypes.AccessDeniedException](err):
+		log.Printf("[DEBUG] Skipping QuickSight Account Subscription (%s) IAM Identity Center application lookup: %s", awsAccountID, err)
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return application.ApplicationArn, nil
+}
+
+func findIdentityCenterApplication(ctx context.Context, conn *ssoadmin.Client, instanceARN, awsAccountID string) (*ssoadmintypes.Application, error) {
+	input := &ssoadmin.ListApplicationsInput{
+		Filter: &ssoadmintypes.ListApplicationsFilter{
+			ApplicationAccount: aws.String(awsAccountID),
+		},
+		InstanceArn: aws.String(instanceARN),
+	}
+
+	pages := ssoadmin.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			if isQuickSightIdentityCenterApplication(v) {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
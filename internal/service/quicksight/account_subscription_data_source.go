@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_quicksight_account_subscription", name="Account Subscription")
+func dataSourceAccountSubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccountSubscriptionRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"account_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"account_subscription_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrAWSAccountID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"edition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"iam_identity_center_instance_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"notification_email": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceAccountSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+
+	out, err := findAccountSubscriptionByID(ctx, conn, awsAccountID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("QuickSight Account Subscription", err))
+	}
+
+	d.SetId(awsAccountID)
+	d.Set("account_name", out.AccountName)
+	d.Set("account_subscription_status", out.AccountSubscriptionStatus)
+	d.Set(names.AttrAWSAccountID, awsAccountID)
+	d.Set("edition", out.Edition)
+	d.Set("iam_identity_center_instance_arn", out.IAMIdentityCenterInstanceArn)
+	d.Set("notification_email", out.NotificationEmail)
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccountSubscriptionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+	dataSourceName := "data.aws_quicksight_account_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_name", resourceName, "account_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_subscription_status", resourceName, "account_subscription_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAWSAccountID, resourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "edition", resourceName, "edition"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_identity_center_instance_arn", resourceName, "iam_identity_center_instance_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "notification_email", resourceName, "notification_email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSubscriptionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAccountSubscriptionConfig_basic(rName),
+		`
+data "aws_quicksight_account_subscription" "test" {
+  aws_account_id = aws_quicksight_account_subscription.test.aws_account_id
+}
+`)
+}
@@ -5,22 +5,843 @@ package quicksight_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	dstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	ssoadmintypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIsActive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[tfquicksight.AccountSubscriptionStatus]bool{
+		tfquicksight.AccountSubscriptionStatusCreated:                 false,
+		tfquicksight.AccountSubscriptionStatusOK:                      true,
+		tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress: false,
+		tfquicksight.AccountSubscriptionStatusUnsuscribeInProgress:    false,
+		tfquicksight.AccountSubscriptionStatusUnsuscribed:             false,
+		"":        false,
+		"UNKNOWN": false,
+	}
+
+	for _, status := range tfquicksight.AccountSubscriptionStatus("").Values() {
+		if _, ok := testCases[status]; !ok {
+			t.Errorf("status %q has no test case", status)
+		}
+	}
+
+	for status, expected := range testCases {
+		t.Run(string(status), func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfquicksight.IsActive(status); got != expected {
+				t.Errorf("IsActive(%q) = %t, want %t", status, got, expected)
+			}
+		})
+	}
+}
+
+func TestAccountDirectoryType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		authenticationType *string
+		expected           string
+	}{
+		"Active Directory": {
+			authenticationType: aws.String(string(awstypes.AuthenticationMethodOptionActiveDirectory)),
+			expected:           "ACTIVE_DIRECTORY",
+		},
+		"IAM Identity Center": {
+			authenticationType: aws.String(string(awstypes.AuthenticationMethodOptionIamIdentityCenter)),
+			expected:           "IAM_IDENTITY_CENTER",
+		},
+		"IAM and QuickSight": {
+			authenticationType: aws.String(string(awstypes.AuthenticationMethodOptionIamAndQuicksight)),
+			expected:           "QUICKSIGHT",
+		},
+		"IAM only": {
+			authenticationType: aws.String(string(awstypes.AuthenticationMethodOptionIamOnly)),
+			expected:           "QUICKSIGHT",
+		},
+		"not set": {
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := &awstypes.AccountInfo{
+				AccountName:               aws.String("example"),
+				AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusOK)),
+				AuthenticationType:        testCase.authenticationType,
+				Edition:                   awstypes.EditionEnterprise,
+			}
+
+			if got, want := tfquicksight.AccountDirectoryType(output), testCase.expected; got != want {
+				t.Errorf("AccountDirectoryType = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAuthenticationMethodSupportsRoleMemberships(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[awstypes.AuthenticationMethodOption]bool{
+		awstypes.AuthenticationMethodOptionActiveDirectory:   true,
+		awstypes.AuthenticationMethodOptionIamIdentityCenter: true,
+		awstypes.AuthenticationMethodOptionIamAndQuicksight:  false,
+		awstypes.AuthenticationMethodOptionIamOnly:           false,
+	}
+
+	for _, method := range awstypes.AuthenticationMethodOption("").Values() {
+		if _, ok := testCases[method]; !ok {
+			t.Errorf("authentication method %q has no test case", method)
+		}
+	}
+
+	for method, expected := range testCases {
+		if got := tfquicksight.AuthenticationMethodSupportsRoleMemberships(method); got != expected {
+			t.Errorf("AuthenticationMethodSupportsRoleMemberships(%q) = %t, want %t", method, got, expected)
+		}
+	}
+}
+
+func TestAccountSubscriptionSignUpOnlyDiffSuppress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		old      string
+		new      string
+		expected bool
+	}{
+		"create": {
+			new: "Jane",
+		},
+		"added to existing": {
+			id:       "123456789012",
+			new:      "Jane",
+			expected: true,
+		},
+		"changed on existing": {
+			id:  "123456789012",
+			old: "Jane",
+			new: "John",
+		},
+		"removed from existing": {
+			id:  "123456789012",
+			old: "Jane",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfquicksight.ResourceAccountSubscription().SchemaMap(), map[string]interface{}{})
+			d.SetId(testCase.id)
+
+			if got := tfquicksight.AccountSubscriptionSignUpOnlyDiffSuppress("first_name", testCase.old, testCase.new, d); got != testCase.expected {
+				t.Errorf("AccountSubscriptionSignUpOnlyDiffSuppress = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccountSubscriptionSignupInProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		output      *awstypes.AccountInfo
+		status      string
+		err         error
+		expected    bool
+		expectError bool
+	}{
+		"not found": {},
+		"sign-up in progress": {
+			output:   &awstypes.AccountInfo{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress))},
+			status:   string(tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress),
+			expected: true,
+		},
+		"account created": {
+			output:   &awstypes.AccountInfo{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusCreated))},
+			status:   string(tfquicksight.AccountSubscriptionStatusCreated),
+			expected: true,
+		},
+		"active": {
+			output: &awstypes.AccountInfo{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusOK))},
+			status: string(tfquicksight.AccountSubscriptionStatusOK),
+		},
+		"unsubscribe in progress": {
+			output: &awstypes.AccountInfo{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusUnsuscribeInProgress))},
+			status: string(tfquicksight.AccountSubscriptionStatusUnsuscribeInProgress),
+		},
+		"error": {
+			err:         errors.New("AccessDeniedException"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			refresh := func() (interface{}, string, error) {
+				return testCase.output, testCase.status, testCase.err
+			}
+
+			got, err := tfquicksight.AccountSubscriptionSignupInProgress(refresh)
+
+			if gotErr := err != nil; gotErr != testCase.expectError {
+				t.Fatalf("error = %v, expectError = %t", err, testCase.expectError)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("AccountSubscriptionSignupInProgress = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSetAccountSubscriptionPollDelay(t *testing.T) { //nolint:paralleltest
+	testCases := map[string]struct {
+		value    string
+		expected time.Duration
+	}{
+		"unset":    {},
+		"duration": {value: "30s", expected: 30 * time.Second},
+		"invalid":  {value: "thirty"},
+		"negative": {value: "-1s"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(tfquicksight.EnvVarAccountSubscriptionPollDelay, testCase.value)
+
+			stateConf := &retry.StateChangeConf{}
+			tfquicksight.SetAccountSubscriptionPollDelay(stateConf)
+
+			if got, want := stateConf.Delay, testCase.expected; got != want {
+				t.Errorf("Delay = %s, want %s", got, want)
+			}
+			if got, want := stateConf.MinTimeout, testCase.expected; got != want {
+				t.Errorf("MinTimeout = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateAccountSubscriptionActiveDirectory(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method        awstypes.AuthenticationMethodOption
+		configured    []string
+		expectedError string
+	}{
+		"IAM_AND_QUICKSIGHT": {
+			method: awstypes.AuthenticationMethodOptionIamAndQuicksight,
+		},
+		"IAM_AND_QUICKSIGHT with realm": {
+			method:        awstypes.AuthenticationMethodOptionIamAndQuicksight,
+			configured:    []string{"realm"},
+			expectedError: "realm can only be set when authentication_method is ACTIVE_DIRECTORY",
+		},
+		"IAM_IDENTITY_CENTER with directory": {
+			method:        awstypes.AuthenticationMethodOptionIamIdentityCenter,
+			configured:    []string{"active_directory_name", "directory_id"},
+			expectedError: "active_directory_name, directory_id can only be set when authentication_method is ACTIVE_DIRECTORY",
+		},
+		"ACTIVE_DIRECTORY with none": {
+			method:        awstypes.AuthenticationMethodOptionActiveDirectory,
+			expectedError: "authentication_method ACTIVE_DIRECTORY requires active_directory_name, directory_id, realm; missing: active_directory_name, directory_id, realm",
+		},
+		"ACTIVE_DIRECTORY with realm": {
+			method:        awstypes.AuthenticationMethodOptionActiveDirectory,
+			configured:    []string{"realm"},
+			expectedError: "authentication_method ACTIVE_DIRECTORY requires active_directory_name, directory_id, realm; missing: active_directory_name, directory_id",
+		},
+		"ACTIVE_DIRECTORY with active_directory_name and directory_id": {
+			method:        awstypes.AuthenticationMethodOptionActiveDirectory,
+			configured:    []string{"active_directory_name", "directory_id"},
+			expectedError: "authentication_method ACTIVE_DIRECTORY requires active_directory_name, directory_id, realm; missing: realm",
+		},
+		"ACTIVE_DIRECTORY with all": {
+			method:     awstypes.AuthenticationMethodOptionActiveDirectory,
+			configured: []string{"active_directory_name", "directory_id", "realm"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfquicksight.ValidateAccountSubscriptionActiveDirectory(testCase.method, testCase.configured)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckAccountSubscriptionDirectory(t *testing.T) {
+	t.Parallel()
+
+	const directoryID = "d-1234567890"
+
+	testCases := map[string]struct {
+		output      *directoryservice.DescribeDirectoriesOutput
+		err         error
+		expectError *regexp.Regexp
+	}{
+		"missing": {
+			err:         &dstypes.EntityDoesNotExistException{Message: aws.String("Directory d-1234567890 does not exist")},
+			expectError: regexache.MustCompile(`Directory Service Directory \(d-1234567890\) not found`),
+		},
+		"empty result": {
+			output:      &directoryservice.DescribeDirectoriesOutput{},
+			expectError: regexache.MustCompile(`Directory Service Directory \(d-1234567890\) not found`),
+		},
+		"wrong type": {
+			output: &directoryservice.DescribeDirectoriesOutput{
+				DirectoryDescriptions: []dstypes.DirectoryDescription{
+					{DirectoryId: aws.String(directoryID), Stage: dstypes.DirectoryStageActive, Type: dstypes.DirectoryTypeSimpleAd},
+				},
+			},
+			expectError: regexache.MustCompile(`is of type SimpleAD`),
+		},
+		"not active": {
+			output: &directoryservice.DescribeDirectoriesOutput{
+				DirectoryDescriptions: []dstypes.DirectoryDescription{
+					{DirectoryId: aws.String(directoryID), Stage: dstypes.DirectoryStageCreating, Type: dstypes.DirectoryTypeMicrosoftAd},
+				},
+			},
+			expectError: regexache.MustCompile(`is Creating`),
+		},
+		"Microsoft AD": {
+			output: &directoryservice.DescribeDirectoriesOutput{
+				DirectoryDescriptions: []dstypes.DirectoryDescription{
+					{DirectoryId: aws.String(directoryID), Stage: dstypes.DirectoryStageActive, Type: dstypes.DirectoryTypeMicrosoftAd},
+				},
+			},
+		},
+		"AD Connector": {
+			output: &directoryservice.DescribeDirectoriesOutput{
+				DirectoryDescriptions: []dstypes.DirectoryDescription{
+					{DirectoryId: aws.String(directoryID), Stage: dstypes.DirectoryStageActive, Type: dstypes.DirectoryTypeAdConnector},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn := newTestDirectoryServiceClient(func(input any) (any, error) {
+				if _, ok := input.(*directoryservice.DescribeDirectoriesInput); !ok {
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+
+				return testCase.output, testCase.err
+			})
+
+			err := tfquicksight.CheckAccountSubscriptionDirectory(ctx, conn, directoryID)
+
+			if testCase.expectError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if !testCase.expectError.MatchString(err.Error()) {
+				t.Errorf("error = %q, want match for %q", err, testCase.expectError)
+			}
+		})
+	}
+}
+
+func TestUpdateAccountSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := &awstypes.AccountSettings{
+		DefaultNamespace:             aws.String("custom"),
+		NotificationEmail:            aws.String("old@example.com"),
+		TerminationProtectionEnabled: true,
+	}
+
+	testCases := map[string]struct {
+		settings                             *awstypes.AccountSettings
+		email                                *string
+		terminationProtectionEnabled         *bool
+		expectError                          bool
+		expectUpdate                         bool
+		expectedEmail                        string
+		expectedTerminationProtectionEnabled bool
+	}{
+		"email": {
+			settings:                             settings,
+			email:                                aws.String("new@example.com"),
+			expectUpdate:                         true,
+			expectedEmail:                        "new@example.com",
+			expectedTerminationProtectionEnabled: true,
+		},
+		"termination protection": {
+			settings:                             settings,
+			terminationProtectionEnabled:         aws.Bool(false),
+			expectUpdate:                         true,
+			expectedEmail:                        "old@example.com",
+			expectedTerminationProtectionEnabled: false,
+		},
+		"both": {
+			settings:                             settings,
+			email:                                aws.String("new@example.com"),
+			terminationProtectionEnabled:         aws.Bool(false),
+			expectUpdate:                         true,
+			expectedEmail:                        "new@example.com",
+			expectedTerminationProtectionEnabled: false,
+		},
+		"unchanged": {
+			settings:                     settings,
+			email:                        aws.String("old@example.com"),
+			terminationProtectionEnabled: aws.Bool(true),
+		},
+		"settings not found": {
+			email:       aws.String("new@example.com"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var inputs []*quicksight.UpdateAccountSettingsInput
+			conn := newTestClient(func(input any) (any, error) {
+				switch input := input.(type) {
+				case *quicksight.DescribeAccountSettingsInput:
+					if testCase.settings == nil {
+						return nil, &awstypes.ResourceNotFoundException{Message: aws.String("account not found")}
+					}
+					return &quicksight.DescribeAccountSettingsOutput{AccountSettings: testCase.settings}, nil
+				case *quicksight.UpdateAccountSettingsInput:
+					inputs = append(inputs, input)
+					return &quicksight.UpdateAccountSettingsOutput{}, nil
+				default:
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+			})
+
+			err := tfquicksight.UpdateAccountSettings(ctx, conn, "123456789012", testCase.email, testCase.terminationProtectionEnabled)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.expectUpdate {
+				if len(inputs) != 0 {
+					t.Fatalf("UpdateAccountSettings called %d times, want 0", len(inputs))
+				}
+				return
+			}
+
+			if len(inputs) != 1 {
+				t.Fatalf("UpdateAccountSettings called %d times, want 1", len(inputs))
+			}
+
+			input := inputs[0]
+			if got, want := aws.ToString(input.NotificationEmail), testCase.expectedEmail; got != want {
+				t.Errorf("NotificationEmail = %q, want %q", got, want)
+			}
+			if got, want := aws.ToString(input.DefaultNamespace), aws.ToString(testCase.settings.DefaultNamespace); got != want {
+				t.Errorf("DefaultNamespace = %q, want %q", got, want)
+			}
+			if got, want := input.TerminationProtectionEnabled, testCase.expectedTerminationProtectionEnabled; got != want {
+				t.Errorf("TerminationProtectionEnabled = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestIsTerminationProtectionError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {},
+		"termination protection": {
+			err:      &awstypes.PreconditionNotMetException{Message: aws.String("Termination protection is enabled for this account.")},
+			expected: true,
+		},
+		"termination protection in sentence": {
+			err:      &awstypes.PreconditionNotMetException{Message: aws.String("The account can't be deleted while termination protection is enabled.")},
+			expected: true,
+		},
+		"other precondition": {
+			err: &awstypes.PreconditionNotMetException{Message: aws.String("The account is not subscribed.")},
+		},
+		"other exception type": {
+			err: &awstypes.InvalidParameterValueException{Message: aws.String("Termination protection is enabled for this account.")},
+		},
+		"other error": {
+			err: &awstypes.AccessDeniedException{Message: aws.String("access denied")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfquicksight.IsTerminationProtectionError(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsTerminationProtectionError = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestStatusAccountSubscriptionIdentityCenter(t *testing.T) {
+	t.Parallel()
+
+	const instanceARN = "arn:aws:sso:::instance/ssoins-1234567890abcdef" //lintignore:AWSAT005
+	responses := []*awstypes.AccountInfo{
+		{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress))},
+		{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusOK))},
+		{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusOK))},
+		{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusOK))},
+		{AccountSubscriptionStatus: aws.String(string(tfquicksight.AccountSubscriptionStatusOK)), IAMIdentityCenterInstanceArn: aws.String(instanceARN)},
+	}
+
+	testCases := map[string]struct {
+		identityCenter bool
+		expectedPolls  int
+		expectedARN    string
+	}{
+		"without Identity Center": {
+			expectedPolls: 3,
+		},
+		"with Identity Center": {
+			identityCenter: true,
+			expectedPolls:  len(responses) + 1,
+			expectedARN:    instanceARN,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var polls int
+			refresh := func() (interface{}, string, error) {
+				output := responses[min(polls, len(responses)-1)]
+				polls++
+
+				return output, aws.ToString(output.AccountSubscriptionStatus), nil
+			}
+			if testCase.identityCenter {
+				refresh = tfquicksight.StatusAccountSubscriptionIdentityCenter(refresh)
+			}
+
+			stateConf := &retry.StateChangeConf{
+				Pending: enum.Slice(
+					tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+					tfquicksight.AccountSubscriptionStatusCreated,
+					tfquicksight.AccountSubscriptionStatusIdentityCenterProvisioning,
+					tfquicksight.AccountSubscriptionStatusPending,
+				),
+				Target:                    enum.Slice(tfquicksight.AccountSubscriptionStatusOK),
+				Refresh:                   refresh,
+				Timeout:                   10 * time.Second,
+				PollInterval:              time.Millisecond,
+				ContinuousTargetOccurence: 2,
+			}
+
+			outputRaw, err := stateConf.WaitForStateContext(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := polls, testCase.expectedPolls; got != want {
+				t.Errorf("polls = %d, want %d", got, want)
+			}
+
+			if got, want := aws.ToString(outputRaw.(*awstypes.AccountInfo).IAMIdentityCenterInstanceArn), testCase.expectedARN; got != want {
+				t.Errorf("IAMIdentityCenterInstanceArn = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitAccountSubscriptionCreated(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statuses      []tfquicksight.AccountSubscriptionStatus
+		expectedPolls int
+		expectError   string
+	}{
+		"account created then OK": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+				tfquicksight.AccountSubscriptionStatusCreated,
+				tfquicksight.AccountSubscriptionStatusOK,
+			},
+			expectedPolls: 4,
+		},
+		"unknown status": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+				"SIGNUP_ATTEMPT_VERIFYING",
+				tfquicksight.AccountSubscriptionStatusOK,
+			},
+			expectedPolls: 4,
+		},
+		"unsubscribed": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+				tfquicksight.AccountSubscriptionStatusUnsuscribed,
+			},
+			expectedPolls: 2,
+			expectError:   string(tfquicksight.AccountSubscriptionStatusUnsuscribed),
+		},
+		"unsubscribe in progress": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusCreated,
+				tfquicksight.AccountSubscriptionStatusUnsuscribeInProgress,
+			},
+			expectedPolls: 2,
+			expectError:   string(tfquicksight.AccountSubscriptionStatusUnsuscribeInProgress),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var polls int
+			conn := newTestClient(func(input any) (any, error) {
+				if _, ok := input.(*quicksight.DescribeAccountSubscriptionInput); !ok {
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+
+				return describeAccountSubscriptionOutput(testCase.statuses, &polls), nil
+			})
+
+			output, err := tfquicksight.WaitAccountSubscriptionCreated(ctx, conn, "123456789012", false, time.Minute)
+
+			if testCase.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if !strings.Contains(err.Error(), testCase.expectError) {
+					t.Errorf("error = %q, want it to contain %q", err, testCase.expectError)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got, want := aws.ToString(output.AccountSubscriptionStatus), string(tfquicksight.AccountSubscriptionStatusOK); got != want {
+					t.Errorf("AccountSubscriptionStatus = %q, want %q", got, want)
+				}
+			}
+
+			if got, want := polls, testCase.expectedPolls; got != want {
+				t.Errorf("polls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestCreateAccountSubscription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statuses        []tfquicksight.AccountSubscriptionStatus
+		expectedCreates int
+	}{
+		"sign-up in progress": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+				tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+				tfquicksight.AccountSubscriptionStatusOK,
+			},
+			expectedCreates: 0,
+		},
+		"account created": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusCreated,
+				tfquicksight.AccountSubscriptionStatusCreated,
+				tfquicksight.AccountSubscriptionStatusOK,
+			},
+			expectedCreates: 0,
+		},
+		"unsubscribed": {
+			statuses: []tfquicksight.AccountSubscriptionStatus{
+				tfquicksight.AccountSubscriptionStatusUnsuscribed,
+				tfquicksight.AccountSubscriptionStatusSignupAttemptInProgress,
+				tfquicksight.AccountSubscriptionStatusOK,
+			},
+			expectedCreates: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			input := &quicksight.CreateAccountSubscriptionInput{
+				AccountName:          aws.String("example"),
+				AwsAccountId:         aws.String("123456789012"),
+				AuthenticationMethod: awstypes.AuthenticationMethodOptionIamAndQuicksight,
+				Edition:              awstypes.EditionEnterprise,
+				NotificationEmail:    aws.String("example@example.com"),
+			}
+
+			var polls int
+			var creates []*quicksight.CreateAccountSubscriptionInput
+			conn := newTestClient(func(input any) (any, error) {
+				switch input := input.(type) {
+				case *quicksight.DescribeAccountSubscriptionInput:
+					return describeAccountSubscriptionOutput(testCase.statuses, &polls), nil
+				case *quicksight.CreateAccountSubscriptionInput:
+					creates = append(creates, input)
+					return &quicksight.CreateAccountSubscriptionOutput{
+						SignupResponse: &awstypes.SignupResponse{IAMUser: true},
+					}, nil
+				default:
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+			})
+
+			output, err := tfquicksight.CreateAccountSubscription(ctx, conn, input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(creates), testCase.expectedCreates; got != want {
+				t.Fatalf("CreateAccountSubscription calls = %d, want %d", got, want)
+			}
+
+			if got, want := output != nil, testCase.expectedCreates > 0; got != want {
+				t.Errorf("output returned = %t, want %t", got, want)
+			}
+
+			// The waiter takes over the sign-up whether it was started now or adopted.
+			info, err := tfquicksight.WaitAccountSubscriptionCreated(ctx, conn, aws.ToString(input.AwsAccountId), false, time.Minute)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(info.AccountSubscriptionStatus), string(tfquicksight.AccountSubscriptionStatusOK); got != want {
+				t.Errorf("AccountSubscriptionStatus = %q, want %q", got, want)
+			}
+
+			if got, want := polls, 4; got != want {
+				t.Errorf("polls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+// describeAccountSubscriptionOutput answers successive DescribeAccountSubscription calls with the specified
+// statuses, repeating the last one.
+func describeAccountSubscriptionOutput(statuses []tfquicksight.AccountSubscriptionStatus, polls *int) *quicksight.DescribeAccountSubscriptionOutput {
+	status := statuses[min(*polls, len(statuses)-1)]
+	*polls++
+
+	return &quicksight.DescribeAccountSubscriptionOutput{
+		AccountInfo: &awstypes.AccountInfo{AccountSubscriptionStatus: aws.String(string(status))},
+	}
+}
+
+func TestIsQuickSightIdentityCenterApplication(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerARN *string
+		expected    bool
+	}{
+		"QuickSight": {
+			providerARN: aws.String("arn:aws:sso::aws:applicationProvider/quicksight"), //lintignore:AWSAT005
+			expected:    true,
+		},
+		"custom": {
+			providerARN: aws.String("arn:aws:sso::aws:applicationProvider/custom"), //lintignore:AWSAT005
+		},
+		"not set": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := ssoadmintypes.Application{
+				ApplicationProviderArn: testCase.providerARN,
+			}
+
+			if got, want := tfquicksight.IsQuickSightIdentityCenterApplication(apiObject), testCase.expected; got != want {
+				t.Errorf("IsQuickSightIdentityCenterApplication = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func testAccAccountSubscription_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var accountsubscription awstypes.AccountInfo
@@ -39,15 +860,238 @@ func testAccAccountSubscription_basic(t *testing.T) {
 			{
 				Config: testAccAccountSubscriptionConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAccountSubscriptionDisableTerminationProtection(ctx, resourceName), // Workaround to remove termination protection
 					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
 					resource.TestCheckResourceAttr(resourceName, "account_name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttr(resourceName, "directory_type", "QUICKSIGHT"),
+					resource.TestCheckNoResourceAttr(resourceName, "termination_protection_enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "iam_user"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					// The sign-up time value is kept across refresh.
+					resource.TestCheckResourceAttrSet(resourceName, "iam_user"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The sign-up response is only returned by CreateAccountSubscription.
+				ImportStateVerifyIgnore: []string{"iam_user"},
+				ImportStateCheck:        acctest.ImportCheckResourceAttr(names.AttrAWSAccountID, acctest.AccountID()),
+			},
+		},
+	})
+}
+
+func testAccAccountSubscription_iamIdentityCenter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsubscription awstypes.AccountInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_iamIdentityCenter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "directory_type", "IAM_IDENTITY_CENTER"),
+					resource.TestCheckResourceAttrSet(resourceName, "iam_identity_center_instance_arn"),
+					resource.TestMatchResourceAttr(resourceName, "iam_identity_center_application_arn", regexache.MustCompile(`^arn:[^:]+:sso::\d{12}:application/.+`)),
 				),
 			},
 			{
 				ResourceName: resourceName,
-				ImportState:  false,
 				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					// The Identity Center values are read back from the account on refresh.
+					resource.TestCheckResourceAttr(resourceName, "directory_type", "IAM_IDENTITY_CENTER"),
+					resource.TestCheckResourceAttrSet(resourceName, "iam_identity_center_instance_arn"),
+					resource.TestMatchResourceAttr(resourceName, "iam_identity_center_application_arn", regexache.MustCompile(`^arn:[^:]+:sso::\d{12}:application/.+`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_user"},
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAccountSubscription_validationOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// No subscription is created, so there is nothing to destroy.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_validationOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, names.AttrID, regexache.MustCompile(`^validation-only-\d{12}$`)),
+					resource.TestCheckResourceAttr(resourceName, "account_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "account_subscription_status", ""),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttr(resourceName, "validation_only", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAccountSubscription_activeDirectoryIncomplete(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountSubscriptionConfig_activeDirectoryIncomplete(rName),
+				ExpectError: regexache.MustCompile(`missing: active_directory_name, directory_id`),
+			},
+		},
+	})
+}
+
+func testAccAccountSubscription_notificationEmail(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsubscription awstypes.AccountInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+	email := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_notificationEmail(rName, acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "notification_email", acctest.DefaultEmailAddress),
+				),
+			},
+			{
+				Config: testAccAccountSubscriptionConfig_notificationEmail(rName, email),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "notification_email", email),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSubscription_terminationProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsubscription awstypes.AccountInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_terminationProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				// Destroy disables termination protection only when the argument is false.
+				Config:      testAccAccountSubscriptionConfig_terminationProtection(rName, true),
+				Destroy:     true,
+				ExpectError: regexache.MustCompile(`Set termination_protection_enabled to false`),
+			},
+			{
+				Config: testAccAccountSubscriptionConfig_terminationProtection(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSubscription_validationOnlyEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsubscription awstypes.AccountInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+				),
+			},
+			{
+				// Enabling validation_only must not plan a replacement, which would delete the subscription.
+				Config:      testAccAccountSubscriptionConfig_validationOnly(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`validation_only can't be enabled for an existing QuickSight Account Subscription`),
 			},
 		},
 	})
@@ -71,7 +1115,6 @@ func testAccAccountSubscription_disappears(t *testing.T) {
 			{
 				Config: testAccAccountSubscriptionConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAccountSubscriptionDisableTerminationProtection(ctx, resourceName), // Workaround to remove termination protection
 					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceAccountSubscription(), resourceName),
 				),
@@ -107,30 +1150,6 @@ func testAccCheckAccountSubscriptionDestroy(ctx context.Context) resource.TestCh
 	}
 }
 
-// Account subscription cannot be automatically deleted after creation. Termination protection
-// must first be disabled which requires a separate API call.
-func testAccCheckAccountSubscriptionDisableTerminationProtection(ctx context.Context, n string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
-
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
-
-		defaultNs := "default"
-		input := &quicksight.UpdateAccountSettingsInput{
-			AwsAccountId:                 aws.String(rs.Primary.ID),
-			DefaultNamespace:             aws.String(defaultNs),
-			TerminationProtectionEnabled: false,
-		}
-
-		_, err := conn.UpdateAccountSettings(ctx, input)
-
-		return err
-	}
-}
-
 func testAccCheckAccountSubscriptionExists(ctx context.Context, n string, v *awstypes.AccountInfo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -162,3 +1181,70 @@ resource "aws_quicksight_account_subscription" "test" {
 }
 `, rName, acctest.DefaultEmailAddress)
 }
+
+func testAccAccountSubscriptionConfig_notificationEmail(rName, email string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_subscription" "test" {
+  account_name          = %[1]q
+  authentication_method = "IAM_AND_QUICKSIGHT"
+  edition               = "ENTERPRISE"
+  notification_email    = %[2]q
+}
+`, rName, email)
+}
+
+func testAccAccountSubscriptionConfig_terminationProtection(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_subscription" "test" {
+  account_name                   = %[1]q
+  authentication_method          = "IAM_AND_QUICKSIGHT"
+  edition                        = "ENTERPRISE"
+  notification_email             = %[2]q
+  termination_protection_enabled = %[3]t
+}
+`, rName, acctest.DefaultEmailAddress, enabled)
+}
+
+func testAccAccountSubscriptionConfig_iamIdentityCenter(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+}
+
+resource "aws_quicksight_account_subscription" "test" {
+  account_name                     = %[1]q
+  authentication_method            = "IAM_IDENTITY_CENTER"
+  edition                          = "ENTERPRISE"
+  iam_identity_center_instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  notification_email               = %[2]q
+  admin_group                      = [aws_identitystore_group.test.display_name]
+}
+`, rName, acctest.DefaultEmailAddress)
+}
+
+func testAccAccountSubscriptionConfig_validationOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_subscription" "test" {
+  account_name          = %[1]q
+  authentication_method = "IAM_AND_QUICKSIGHT"
+  edition               = "ENTERPRISE"
+  notification_email    = %[2]q
+  validation_only       = true
+}
+`, rName, acctest.DefaultEmailAddress)
+}
+
+func testAccAccountSubscriptionConfig_activeDirectoryIncomplete(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_subscription" "test" {
+  account_name          = %[1]q
+  authentication_method = "ACTIVE_DIRECTORY"
+  edition               = "ENTERPRISE"
+  notification_email    = %[2]q
+  realm                 = "example.com"
+}
+`, rName, acctest.DefaultEmailAddress)
+}
@@ -5,6 +5,7 @@ package quicksight
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -44,6 +47,7 @@ func resourceAnalysis() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("recovery_window_in_days", 30) //nolint:mnd // 30days is the default value (see below)
+				d.Set("warn_on_pending_deletion", false)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -57,9 +61,10 @@ func resourceAnalysis() *schema.Resource {
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"analysis_id": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: quicksightschema.ValidResourceID(quicksightschema.ResourceIDMaxLen),
 				},
 				names.AttrARN: {
 					Type:     schema.TypeString,
@@ -76,7 +81,12 @@ func resourceAnalysis() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
-				"definition": quicksightschema.AnalysisDefinitionSchema(),
+				"definition":  quicksightschema.AnalysisDefinitionSchema(),
+				"folder_arns": folderARNsSchema(),
+				names.AttrForceDelete: {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				"last_published_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -112,11 +122,116 @@ func resourceAnalysis() *schema.Resource {
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+				"validation_strategy": validationStrategySchema(),
+				"warn_on_pending_deletion": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			analysisDeleteOptionsCustomizeDiff,
+			analysisPermissionsCustomizeDiff,
+			definitionDataSetIdentifiersCustomizeDiff,
+			definitionParameterDeclarationsCustomizeDiff,
+			folderARNsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func analysisDeleteOptionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get(names.AttrForceDelete).(bool) {
+		return nil
+	}
+
+	if v := diff.GetRawConfig().GetAttr("recovery_window_in_days"); v.IsKnown() && !v.IsNull() {
+		return fmt.Errorf("%s cannot be set when recovery_window_in_days is configured", names.AttrForceDelete)
+	}
+
+	return nil
+}
+
+func analysisPermissionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	v, ok := diff.Get(names.AttrPermissions).(*schema.Set)
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	if err := quicksightschema.ValidateNoLinkSharingPrincipals(v.List()); err != nil {
+		return fmt.Errorf("%s: %w", names.AttrPermissions, err)
+	}
+
+	return nil
+}
+
+// definitionDataSetIdentifiersCustomizeDiff reports sheets of an analysis or dashboard definition that
+// reference a data set identifier missing from data_set_identifiers_declarations, which the API otherwise
+// rejects with an error that doesn't name the sheet.
+func definitionDataSetIdentifiersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	v, ok := diff.Get("definition").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := v[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := quicksightschema.ValidateSheetDataSetIdentifiers(tfMap); err != nil {
+		return fmt.Errorf("definition: %w", err)
+	}
+
+	return nil
+}
+
+// definitionParameterDeclarationsCustomizeDiff validates the parameter_declarations of an analysis or dashboard definition.
+func definitionParameterDeclarationsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	v, ok := diff.Get("definition").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := v[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if v, ok := tfMap["parameter_declarations"].(*schema.Set); ok && v.Len() > 0 {
+		if err := quicksightschema.ValidateParameterDeclarations(v.List()); err != nil {
+			return fmt.Errorf("definition: %w", err)
+		}
+	}
+
+	return nil
+}
+
+const (
+	// QuickSight doesn't document a request size limit for definitions. This heuristic estimate is used only to warn early.
+	definitionSizeEstimatedLimit   = 2 * 1024 * 1024
+	definitionSizeWarningThreshold = definitionSizeEstimatedLimit * 8 / 10
+)
+
+// definitionSizeNearLimit returns the approximate serialized size in bytes of an analysis or dashboard definition and
+// whether it is close to the estimated API request size limit. Oversized requests fail with errors that don't mention size.
+func definitionSizeNearLimit(definition any) (int, bool) {
+	b, err := json.Marshal(definition)
+	if err != nil {
+		return 0, false
+	}
+
+	return len(b), len(b) >= definitionSizeWarningThreshold
+}
+
+func appendDefinitionSizeWarning(diags diag.Diagnostics, resourceType, id string, definition any) diag.Diagnostics {
+	if size, ok := definitionSizeNearLimit(definition); ok {
+		diags = sdkdiag.AppendWarningf(diags, "QuickSight %s (%s) definition is about %d KiB. QuickSight doesn't document a definition size limit, but requests over about %d KiB (a heuristic estimate) may fail with errors that don't mention size. If the request fails, import very large definitions as an asset bundle with StartAssetBundleImportJob instead", resourceType, id, size/1024, definitionSizeEstimatedLimit/1024)
 	}
+
+	return diags
 }
 
 func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -138,12 +253,17 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
+		diags = appendDefinitionSizeWarning(diags, "Analysis", id, input.Definition)
 	}
 
 	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Parameters = quicksightschema.ExpandParameters(d.Get(names.AttrParameters).([]interface{}))
 	}
 
+	if v, ok := d.GetOk("folder_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FolderArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk(names.AttrPermissions); ok && v.(*schema.Set).Len() != 0 {
 		input.Permissions = quicksightschema.ExpandResourcePermissions(v.(*schema.Set).List())
 	}
@@ -156,6 +276,8 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ThemeArn = aws.String(v)
 	}
 
+	input.ValidationStrategy = expandValidationStrategy(d)
+
 	_, err := conn.CreateAnalysis(ctx, input)
 
 	if err != nil {
@@ -180,7 +302,11 @@ func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	analysis, err := findAnalysisByTwoPartKey(ctx, conn, awsAccountID, analysisID)
+	// Look the analysis up without filtering on status so that one deleted with a recovery window can be detected.
+	analysis, err := findAnalysis(ctx, conn, &quicksight.DescribeAnalysisInput{
+		AnalysisId:   aws.String(analysisID),
+		AwsAccountId: aws.String(awsAccountID),
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] QuickSight Analysis (%s) not found, removing from state", d.Id())
@@ -192,6 +318,20 @@ func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "reading QuickSight Analysis (%s): %s", d.Id(), err)
 	}
 
+	if !d.IsNewResource() && analysis.Status == awstypes.ResourceStatusDeleted {
+		if !d.Get("warn_on_pending_deletion").(bool) {
+			log.Printf("[WARN] QuickSight Analysis (%s) is pending deletion, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+
+		// The definition and permissions of a deleted analysis can't be described, so keep the values already in state.
+		d.Set(names.AttrStatus, analysis.Status)
+		d.Set(names.AttrLastUpdatedTime, analysis.LastUpdatedTime.Format(time.RFC3339))
+
+		return sdkdiag.AppendWarningf(diags, "QuickSight Analysis (%s) is deleted and pending permanent deletion at the end of its recovery window. Restore it with RestoreAnalysis or remove it from the configuration", d.Id())
+	}
+
 	d.Set("analysis_id", analysis.AnalysisId)
 	d.Set(names.AttrARN, analysis.Arn)
 	d.Set(names.AttrAWSAccountID, awsAccountID)
@@ -211,6 +351,19 @@ func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
 	}
 
+	if folderARNsInUse(d) {
+		folderARNs, err := findFolderARNsForResource(ctx, conn, awsAccountID, aws.ToString(analysis.Arn))
+
+		switch {
+		case isFolderListingUnavailableError(err):
+			diags = sdkdiag.AppendWarningf(diags, "reading QuickSight Analysis (%s) folders: %s", d.Id(), err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading QuickSight Analysis (%s) folders: %s", d.Id(), err)
+		default:
+			d.Set("folder_arns", folderARNs)
+		}
+	}
+
 	permissions, err := findAnalysisPermissionsByTwoPartKey(ctx, conn, awsAccountID, analysisID)
 
 	if err != nil {
@@ -233,7 +386,7 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("folder_arns", names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "validation_strategy") {
 		input := &quicksight.UpdateAnalysisInput{
 			AnalysisId:   aws.String(analysisID),
 			AwsAccountId: aws.String(awsAccountID),
@@ -244,6 +397,7 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.SourceEntity = quicksightschema.ExpandAnalysisSourceEntity(v.([]interface{}))
 		} else {
 			input.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
+			diags = appendDefinitionSizeWarning(diags, "Analysis", d.Id(), input.Definition)
 		}
 
 		if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -254,6 +408,8 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ThemeArn = aws.String(v)
 		}
 
+		input.ValidationStrategy = expandValidationStrategy(d)
+
 		_, err := conn.UpdateAnalysis(ctx, input)
 
 		if err != nil {
@@ -265,6 +421,15 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Removing a folder ARN only removes the folder membership, never the analysis itself.
+	if d.HasChange("folder_arns") {
+		o, n := d.GetChange("folder_arns")
+
+		if err := updateFolderMemberships(ctx, conn, awstypes.MemberTypeAnalysis, analysisID, flex.ExpandStringValueSet(o.(*schema.Set)), flex.ExpandStringValueSet(n.(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Analysis (%s) folders: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrPermissions) {
 		o, n := d.GetChange(names.AttrPermissions)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
@@ -307,12 +472,18 @@ func resourceAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta in
 		AwsAccountId: aws.String(awsAccountID),
 	}
 
-	if v := d.Get("recovery_window_in_days").(int); v == 0 {
+	if v := d.Get("recovery_window_in_days").(int); v == 0 || d.Get(names.AttrForceDelete).(bool) {
 		input.ForceDeleteWithoutRecovery = true
 	} else {
 		input.RecoveryWindowInDays = aws.Int64(int64(v))
 	}
 
+	// An analysis already pending deletion is removed by AWS at the end of its recovery window.
+	if d.Get(names.AttrStatus).(string) == string(awstypes.ResourceStatusDeleted) && !input.ForceDeleteWithoutRecovery {
+		log.Printf("[INFO] QuickSight Analysis (%s) is already pending deletion", d.Id())
+		return diags
+	}
+
 	log.Printf("[INFO] Deleting QuickSight Analysis: %s", d.Id())
 	_, err = conn.DeleteAnalysis(ctx, input)
 
@@ -6,9 +6,14 @@ package quicksight_test
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -19,6 +24,77 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDefinitionSizeNearLimit(t *testing.T) {
+	t.Parallel()
+
+	// Each sheet description is 64 KiB, so 32 sheets serialize to just over 2 MiB.
+	description := strings.Repeat("x", 64*1024)
+	sheets := func(n int) []awstypes.SheetDefinition {
+		var apiObjects []awstypes.SheetDefinition
+		for i := range n {
+			apiObjects = append(apiObjects, awstypes.SheetDefinition{
+				Description: aws.String(description),
+				SheetId:     aws.String(fmt.Sprintf("sheet%d", i)),
+			})
+		}
+		return apiObjects
+	}
+
+	testCases := map[string]struct {
+		definition any
+		expected   bool
+	}{
+		"nil": {
+			definition: (*awstypes.DashboardVersionDefinition)(nil),
+		},
+		"small dashboard": {
+			definition: &awstypes.DashboardVersionDefinition{Sheets: sheets(1)},
+		},
+		"oversized dashboard": {
+			definition: &awstypes.DashboardVersionDefinition{Sheets: sheets(32)},
+			expected:   true,
+		},
+		"small analysis": {
+			definition: &awstypes.AnalysisDefinition{Sheets: sheets(1)},
+		},
+		"oversized analysis": {
+			definition: &awstypes.AnalysisDefinition{Sheets: sheets(32)},
+			expected:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			size, got := tfquicksight.DefinitionSizeNearLimit(testCase.definition)
+
+			if got != testCase.expected {
+				t.Errorf("DefinitionSizeNearLimit = %t (size %d), want %t", got, size, testCase.expected)
+			}
+
+			diags := tfquicksight.AppendDefinitionSizeWarning(nil, "Dashboard", "123456789012,example", testCase.definition)
+
+			if !testCase.expected {
+				if len(diags) != 0 {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1", len(diags))
+			}
+			if diags[0].Severity != diag.Warning {
+				t.Errorf("severity = %v, want warning", diags[0].Severity)
+			}
+			if !strings.Contains(diags[0].Summary, "StartAssetBundleImportJob") {
+				t.Errorf("warning %q doesn't suggest asset bundle import", diags[0].Summary)
+			}
+		})
+	}
+}
+
 func TestAccQuickSightAnalysis_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var analysis awstypes.Analysis
@@ -216,6 +292,96 @@ func TestAccQuickSightAnalysis_forceDelete(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightAnalysis_forceDeleteArgument(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysis awstypes.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig_deleteOptions(rId, rName, true, 0, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDelete, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "recovery_window_in_days", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAnalysis_forceDeleteConflictsWithRecoveryWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalysisConfig_deleteOptions(rId, rName, true, 7, false),
+				ExpectError: regexache.MustCompile(`force_delete cannot be set when recovery_window_in_days is configured`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAnalysis_recoveryWindowInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalysisConfig_deleteOptions(rId, rName, false, 3, false),
+				ExpectError: regexache.MustCompile(`expected recovery_window_in_days to be in the range \(7 - 30\)`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAnalysis_permissionsLinkSharing(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalysisConfig_permissionsLinkSharing(rId, rName),
+				ExpectError: regexache.MustCompile(`analyses don't support anonymous link sharing`),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightAnalysis_Definition_calculatedFields(t *testing.T) {
 	ctx := acctest.Context(t)
 	var analysis awstypes.Analysis
@@ -293,6 +459,99 @@ func TestAccQuickSightAnalysis_theme(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightAnalysis_pendingDeletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysis awstypes.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig_deleteOptions(rId, rName, false, 7, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "warn_on_pending_deletion", acctest.CtTrue),
+					// Deletes with the 7 day recovery window from state, leaving the analysis in DELETED status.
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceAnalysis(), resourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceStatusDeleted)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAnalysis_validationStrategyStrict(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysis awstypes.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalysisConfig_validationStrategy(rId, rName, string(awstypes.ValidationStrategyModeStrict), "MissingColumn"),
+				ExpectError: regexache.MustCompile(`CREATION_FAILED|InvalidParameterValueException`),
+			},
+			{
+				// The same definition is accepted when validation is lenient.
+				Config: testAccAnalysisConfig_validationStrategy(rId, rName, string(awstypes.ValidationStrategyModeLenient), "MissingColumn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "analysis_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "validation_strategy", string(awstypes.ValidationStrategyModeLenient)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validation_strategy"},
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAnalysis_validationStrategyInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalysisConfig_validationStrategy(rId, rName, "RELAXED", "Column1"),
+				ExpectError: regexache.MustCompile(`expected validation_strategy to be one of`),
+			},
+		},
+	})
+}
+
 func testAccCheckAnalysisDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
@@ -678,6 +937,90 @@ resource "aws_quicksight_analysis" "test" {
 `, rId, rName))
 }
 
+// testAccAnalysisConfig_deleteOptions leaves recovery_window_in_days unset when recoveryWindowInDays is 0.
+func testAccAnalysisConfig_deleteOptions(rId, rName string, forceDelete bool, recoveryWindowInDays int, warnOnPendingDeletion bool) string {
+	recoveryWindow := "null"
+	if recoveryWindowInDays > 0 {
+		recoveryWindow = strconv.Itoa(recoveryWindowInDays)
+	}
+
+	return acctest.ConfigCompose(
+		testAccAnalysisConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  force_delete             = %[3]t
+  recovery_window_in_days  = %[4]s
+  warn_on_pending_deletion = %[5]t
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName, forceDelete, recoveryWindow, warnOnPendingDeletion))
+}
+
+func testAccAnalysisConfig_permissionsLinkSharing(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+
+  permissions {
+    actions = [
+      "quicksight:DescribeAnalysis",
+      "quicksight:QueryAnalysis",
+    ]
+    principal = "*"
+  }
+}
+`, rId, rName))
+}
+
 func testAccAnalysisConfig_Definition_calculatedFields(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccAnalysisConfig_base(rId, rName),
@@ -822,3 +1165,61 @@ resource "aws_quicksight_analysis" "test" {
 }
 `, rId, rName, themeArn))
 }
+
+func testAccAnalysisConfig_validationStrategy(rId, rName, mode, columnName string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id         = %[1]q
+  name                = %[2]q
+  validation_strategy = %[3]q
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        line_chart_visual {
+          visual_id = "LineChart"
+          title {
+            format_text {
+              plain_text = "Line Chart Test"
+            }
+          }
+          chart_configuration {
+            field_wells {
+              line_chart_aggregated_field_wells {
+                category {
+                  categorical_dimension_field {
+                    field_id = "1"
+                    column {
+                      data_set_identifier = "1"
+                      column_name         = %[4]q
+                    }
+                  }
+                }
+                values {
+                  categorical_measure_field {
+                    field_id = "2"
+                    column {
+                      data_set_identifier = "1"
+                      column_name         = %[4]q
+                    }
+                    aggregation_function = "COUNT"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rId, rName, mode, columnName))
+}
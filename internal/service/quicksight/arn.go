@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceARN returns the ARN of a QuickSight resource, e.g. arn:aws:quicksight:us-west-2:123456789012:dataset/example.
+func resourceARN(partition, region, awsAccountID, resourceType string, resourceParts ...string) string {
+	resource := resourceType
+	for _, v := range resourceParts {
+		resource += "/" + v
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   names.QuickSightEndpointID,
+		Region:    region,
+		AccountID: awsAccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// parseAnalysisARN returns the AWS account ID and analysis ID from a QuickSight analysis ARN.
+func parseAnalysisARN(s string) (string, string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", "", err
+	}
+
+	analysisID, ok := strings.CutPrefix(v.Resource, "analysis/")
+	if !ok || analysisID == "" {
+		return "", "", fmt.Errorf("%q is not a QuickSight analysis ARN", s)
+	}
+
+	return v.AccountID, analysisID, nil
+}
+
+// parseDataSetARN returns the AWS account ID and data set ID from a QuickSight data set ARN.
+func parseDataSetARN(s string) (string, string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", "", err
+	}
+
+	dataSetID, ok := strings.CutPrefix(v.Resource, "dataset/")
+	if !ok || dataSetID == "" {
+		return "", "", fmt.Errorf("%q is not a QuickSight data set ARN", s)
+	}
+
+	return v.AccountID, dataSetID, nil
+}
+
+func folderARN(partition, region, awsAccountID, folderID string) string {
+	return resourceARN(partition, region, awsAccountID, "folder", folderID)
+}
+
+// parseFolderARN returns the AWS account ID and folder ID of a QuickSight folder ARN.
+func parseFolderARN(s string) (string, string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", "", err
+	}
+
+	folderID, ok := strings.CutPrefix(v.Resource, "folder/")
+	if !ok || folderID == "" {
+		return "", "", fmt.Errorf("%q is not a QuickSight folder ARN", s)
+	}
+
+	return v.AccountID, folderID, nil
+}
+
+// validFolderARN is a schema.SchemaValidateFunc that checks that a value is a QuickSight folder ARN.
+func validFolderARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return ws, errors
+	}
+
+	if _, _, err := parseFolderARN(value); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+		return ws, errors
+	}
+
+	if parsed, _ := arn.Parse(value); parsed.Service != names.QuickSightEndpointID {
+		errors = append(errors, fmt.Errorf("%s: %q is not a QuickSight folder ARN", k, value))
+	}
+
+	return ws, errors
+}
+
+func namespaceARN(partition, region, awsAccountID, namespace string) string {
+	return resourceARN(partition, region, awsAccountID, "namespace", namespace)
+}
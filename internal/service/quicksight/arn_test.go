@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"testing"
+
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+)
+
+func TestResourceARNs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		partition    = "aws"
+		region       = "us-west-2" //lintignore:AWSAT003
+		awsAccountID = "123456789012"
+	)
+
+	testCases := map[string]struct {
+		arn      string
+		expected string
+	}{
+		"folder": {
+			arn:      tfquicksight.FolderARN(partition, region, awsAccountID, "example"),
+			expected: "arn:aws:quicksight:us-west-2:123456789012:folder/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"namespace": {
+			arn:      tfquicksight.NamespaceARN(partition, region, awsAccountID, "example"),
+			expected: "arn:aws:quicksight:us-west-2:123456789012:namespace/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"resource": {
+			arn:      tfquicksight.ResourceARN(partition, region, awsAccountID, "dataset", "example"),
+			expected: "arn:aws:quicksight:us-west-2:123456789012:dataset/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"namespaced resource": {
+			arn:      tfquicksight.ResourceARN(partition, region, awsAccountID, "group", "default", "example"),
+			expected: "arn:aws:quicksight:us-west-2:123456789012:group/default/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"China partition folder": {
+			arn:      tfquicksight.FolderARN("aws-cn", "cn-north-1", awsAccountID, "example"), //lintignore:AWSAT003
+			expected: "arn:aws-cn:quicksight:cn-north-1:123456789012:folder/example",          //lintignore:AWSAT003,AWSAT005
+		},
+		"China partition namespace": {
+			arn:      tfquicksight.NamespaceARN("aws-cn", "cn-north-1", awsAccountID, "example"), //lintignore:AWSAT003
+			expected: "arn:aws-cn:quicksight:cn-north-1:123456789012:namespace/example",          //lintignore:AWSAT003,AWSAT005
+		},
+		"GovCloud partition folder": {
+			arn:      tfquicksight.FolderARN("aws-us-gov", "us-gov-west-1", awsAccountID, "example"), //lintignore:AWSAT003
+			expected: "arn:aws-us-gov:quicksight:us-gov-west-1:123456789012:folder/example",          //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.arn, testCase.expected; got != want {
+				t.Errorf("ARN = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseFolderARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn                  string
+		expectedAWSAccountID string
+		expectedFolderID     string
+		expectError          bool
+	}{
+		"empty": {
+			arn:         "",
+			expectError: true,
+		},
+		"not an ARN": {
+			arn:         "folder/example",
+			expectError: true,
+		},
+		"not a folder": {
+			arn:         "arn:aws:quicksight:us-west-2:123456789012:dashboard/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"no folder ID": {
+			arn:         "arn:aws:quicksight:us-west-2:123456789012:folder/", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"folder": {
+			arn:                  "arn:aws:quicksight:us-west-2:123456789012:folder/example", //lintignore:AWSAT003,AWSAT005
+			expectedAWSAccountID: "123456789012",
+			expectedFolderID:     "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			awsAccountID, folderID, err := tfquicksight.ParseFolderARN(testCase.arn)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError = %t", err, want)
+			}
+
+			if got, want := awsAccountID, testCase.expectedAWSAccountID; got != want {
+				t.Errorf("AWS account ID = %q, want %q", got, want)
+			}
+
+			if got, want := folderID, testCase.expectedFolderID; got != want {
+				t.Errorf("folder ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFolderARNRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition string
+		region    string
+	}{
+		"commercial": {
+			partition: "aws",
+			region:    "us-west-2", //lintignore:AWSAT003
+		},
+		"China": {
+			partition: "aws-cn",
+			region:    "cn-north-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			awsAccountID, folderID, err := tfquicksight.ParseFolderARN(tfquicksight.FolderARN(testCase.partition, testCase.region, "123456789012", "example"))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := awsAccountID, "123456789012"; got != want {
+				t.Errorf("AWS account ID = %q, want %q", got, want)
+			}
+
+			if got, want := folderID, "example"; got != want {
+				t.Errorf("folder ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseAnalysisARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn                  string
+		expectedAWSAccountID string
+		expectedAnalysisID   string
+		expectError          bool
+	}{
+		"not an ARN": {
+			arn:         "analysis/example",
+			expectError: true,
+		},
+		"not an analysis": {
+			arn:         "arn:aws:quicksight:us-west-2:123456789012:dashboard/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"analysis": {
+			arn:                  "arn:aws:quicksight:us-west-2:123456789012:analysis/example", //lintignore:AWSAT003,AWSAT005
+			expectedAWSAccountID: "123456789012",
+			expectedAnalysisID:   "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			awsAccountID, analysisID, err := tfquicksight.ParseAnalysisARN(testCase.arn)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError = %t", err, want)
+			}
+
+			if got, want := awsAccountID, testCase.expectedAWSAccountID; got != want {
+				t.Errorf("AWS account ID = %q, want %q", got, want)
+			}
+
+			if got, want := analysisID, testCase.expectedAnalysisID; got != want {
+				t.Errorf("analysis ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseDataSetARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn                  string
+		expectedAWSAccountID string
+		expectedDataSetID    string
+		expectError          bool
+	}{
+		"not an ARN": {
+			arn:         "dataset/example",
+			expectError: true,
+		},
+		"not a data set": {
+			arn:         "arn:aws:quicksight:us-west-2:123456789012:datasource/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"no data set ID": {
+			arn:         "arn:aws:quicksight:us-west-2:123456789012:dataset/", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"data set": {
+			arn:                  "arn:aws:quicksight:us-west-2:123456789012:dataset/example", //lintignore:AWSAT003,AWSAT005
+			expectedAWSAccountID: "123456789012",
+			expectedDataSetID:    "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			awsAccountID, dataSetID, err := tfquicksight.ParseDataSetARN(testCase.arn)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError = %t", err, want)
+			}
+
+			if got, want := awsAccountID, testCase.expectedAWSAccountID; got != want {
+				t.Errorf("AWS account ID = %q, want %q", got, want)
+			}
+
+			if got, want := dataSetID, testCase.expectedDataSetID; got != want {
+				t.Errorf("data set ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidFolderARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"empty": {
+			value:       "",
+			expectError: true,
+		},
+		"not an ARN": {
+			value:       "example",
+			expectError: true,
+		},
+		"data set": {
+			value:       "arn:aws:quicksight:us-west-2:123456789012:dataset/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"other service": {
+			value:       "arn:aws:s3:us-west-2:123456789012:folder/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"folder": {
+			value: "arn:aws:quicksight:us-west-2:123456789012:folder/example", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := tfquicksight.ValidFolderARN(testCase.value, "folder_arns")
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Errorf("errors = %v, expectError = %t", errs, want)
+			}
+		})
+	}
+}
@@ -9,4 +9,5 @@ import (
 
 const (
 	iamPropagationTimeout = 2 * time.Minute
+	listThrottlingTimeout = 2 * time.Minute
 )
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -70,12 +71,22 @@ func resourceDashboard() *schema.Resource {
 					Computed: true,
 				},
 				"dashboard_id": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: quicksightschema.ValidResourceID(quicksightschema.ResourceIDMaxLen),
 				},
 				"dashboard_publish_options": quicksightschema.DashboardPublishOptionsSchema(),
 				"definition":                quicksightschema.DashboardDefinitionSchema(),
+				"delete_version_numbers": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+				"folder_arns": folderARNsSchema(),
 				"last_published_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -106,19 +117,27 @@ func resourceDashboard() *schema.Resource {
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+				"validation_strategy": validationStrategySchema(),
 				"version_description": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: validation.StringLenBetween(1, 512),
+					ValidateFunc: quicksightschema.ValidVersionDescription(),
 				},
 				"version_number": {
 					Type:     schema.TypeInt,
 					Computed: true,
 				},
+				"version_retention_count": versionRetentionCountSchema(),
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			dashboardSourceModeCustomizeDiff,
+			definitionDataSetIdentifiersCustomizeDiff,
+			definitionParameterDeclarationsCustomizeDiff,
+			folderARNsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -149,12 +168,21 @@ func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
+		diags = appendDefinitionSizeWarning(diags, "Dashboard", id, input.Definition)
+
+		if err := validateDashboardDefinitionDataSetReferences(ctx, conn, input.Definition); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating QuickSight Dashboard (%s): %s", id, err)
+		}
 	}
 
 	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Parameters = quicksightschema.ExpandParameters(d.Get(names.AttrParameters).([]interface{}))
 	}
 
+	if v, ok := d.GetOk("folder_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FolderArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk(names.AttrPermissions); ok && v.(*schema.Set).Len() != 0 {
 		input.Permissions = quicksightschema.ExpandResourcePermissions(v.(*schema.Set).List())
 	}
@@ -167,6 +195,8 @@ func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.VersionDescription = aws.String(v.(string))
 	}
 
+	input.ValidationStrategy = expandValidationStrategy(d)
+
 	_, err := conn.CreateDashboard(ctx, input)
 
 	if err != nil {
@@ -215,6 +245,11 @@ func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta int
 	version := aws.ToInt64(dashboard.Version.VersionNumber)
 	d.Set("version_number", version)
 
+	// Versions with errors (e.g. broken visuals) still exist, so surface them without failing the read.
+	if versionErrors := dashboardVersionErrors(dashboard.Version); len(versionErrors) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "QuickSight Dashboard (%s) version %d has errors: %s", d.Id(), version, strings.Join(versionErrors, "; "))
+	}
+
 	outputDDD, err := findDashboardDefinitionByThreePartKey(ctx, conn, awsAccountID, dashboardID, version)
 
 	if err != nil {
@@ -229,6 +264,19 @@ func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting dashboard_publish_options: %s", err)
 	}
 
+	if folderARNsInUse(d) {
+		folderARNs, err := findFolderARNsForResource(ctx, conn, awsAccountID, aws.ToString(dashboard.Arn))
+
+		switch {
+		case isFolderListingUnavailableError(err):
+			diags = sdkdiag.AppendWarningf(diags, "reading QuickSight Dashboard (%s) folders: %s", d.Id(), err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading QuickSight Dashboard (%s) folders: %s", d.Id(), err)
+		default:
+			d.Set("folder_arns", folderARNs)
+		}
+	}
+
 	permissions, err := findDashboardPermissionsByTwoPartKey(ctx, conn, awsAccountID, dashboardID)
 
 	if err != nil {
@@ -251,7 +299,7 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("delete_version_numbers", "folder_arns", names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "validation_strategy", "version_retention_count") {
 		inputUD := &quicksight.UpdateDashboardInput{
 			AwsAccountId:       aws.String(awsAccountID),
 			DashboardId:        aws.String(dashboardID),
@@ -267,12 +315,15 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			inputUD.SourceEntity = quicksightschema.ExpandDashboardSourceEntity(v.([]interface{}))
 		} else {
 			inputUD.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
+			diags = appendDefinitionSizeWarning(diags, "Dashboard", d.Id(), inputUD.Definition)
 		}
 
 		if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			inputUD.Parameters = quicksightschema.ExpandParameters(d.Get(names.AttrParameters).([]interface{}))
 		}
 
+		inputUD.ValidationStrategy = expandValidationStrategy(d)
+
 		output, err := conn.UpdateDashboard(ctx, inputUD)
 
 		if err != nil {
@@ -298,6 +349,27 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if v, ok := d.GetOk("delete_version_numbers"); ok && v.(*schema.Set).Len() > 0 {
+		if err := deleteDashboardVersions(ctx, conn, awsAccountID, dashboardID, flex.ExpandInt64ValueSet(v.(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting QuickSight Dashboard (%s) versions: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("version_retention_count"); ok {
+		if err := pruneDashboardVersions(ctx, conn, awsAccountID, dashboardID, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning QuickSight Dashboard (%s) versions: %s", d.Id(), err)
+		}
+	}
+
+	// Removing a folder ARN only removes the folder membership, never the dashboard itself.
+	if d.HasChange("folder_arns") {
+		o, n := d.GetChange("folder_arns")
+
+		if err := updateFolderMemberships(ctx, conn, awstypes.MemberTypeDashboard, dashboardID, flex.ExpandStringValueSet(o.(*schema.Set)), flex.ExpandStringValueSet(n.(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Dashboard (%s) folders: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrPermissions) {
 		o, n := d.GetChange(names.AttrPermissions)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
@@ -335,6 +407,7 @@ func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// Without a version number, DeleteDashboard removes the dashboard along with all of its versions.
 	log.Printf("[INFO] Deleting QuickSight Dashboard: %s", d.Id())
 	_, err = conn.DeleteDashboard(ctx, &quicksight.DeleteDashboardInput{
 		AwsAccountId: aws.String(awsAccountID),
@@ -371,10 +444,100 @@ func dashboardParseResourceID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// dashboardSourceModeCustomizeDiff forces replacement when a dashboard switches between being
+// published from source_entity and being described by definition. definition is computed from the
+// published version, so only the presence of source_entity identifies the mode. An imported dashboard
+// has no source_entity in state, so switching it to definition only changes definition.
+func dashboardSourceModeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChanges("source_entity", "definition") {
+		return nil
+	}
+
+	o, n := d.GetChange("source_entity")
+	if !dashboardSourceModeChanged(o.([]interface{}), n.([]interface{}), d.Get("source_entity_arn").(string)) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] QuickSight Dashboard (%s) switches between source_entity and definition, forcing replacement", d.Id())
+
+	if !d.HasChange("source_entity") {
+		return d.ForceNew("definition")
+	}
+
+	return d.ForceNew("source_entity")
+}
+
+// dashboardSourceModeChanged returns whether the configured source_entity changes the dashboard between
+// source entity and definition modes. An imported dashboard has no source_entity in state, so the
+// source_entity_arn of the published version identifies a dashboard created from a source entity.
+func dashboardSourceModeChanged(oldSourceEntity, newSourceEntity []interface{}, sourceEntityARN string) bool {
+	fromSourceEntity := len(oldSourceEntity) > 0 || sourceEntityARN != ""
+	toSourceEntity := len(newSourceEntity) > 0
+
+	return fromSourceEntity != toSourceEntity
+}
+
+// validateDashboardDefinitionDataSetReferences verifies that every data set declared in the definition exists,
+// so that a dangling reference is reported by name rather than as a generic creation failure.
+func validateDashboardDefinitionDataSetReferences(ctx context.Context, conn *quicksight.Client, definition *awstypes.DashboardVersionDefinition) error {
+	if definition == nil {
+		return nil
+	}
+
+	for _, v := range definition.DataSetIdentifierDeclarations {
+		dataSetARN := aws.ToString(v.DataSetArn)
+		awsAccountID, dataSetID, err := parseDataSetARN(dataSetARN)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = findDataSetByTwoPartKey(ctx, conn, awsAccountID, dataSetID)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("definition data set identifier (%s) references QuickSight Data Set (%s) that does not exist", aws.ToString(v.Identifier), dataSetARN)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading QuickSight Data Set (%s): %w", dataSetARN, err)
+		}
+	}
+
+	return nil
+}
+
 func versionFromDashboardARN(arn string) int64 {
 	return flex.StringValueToInt64Value(arn[strings.LastIndex(arn, "/")+1:])
 }
 
+// dashboardVersionErrors returns a description of each of a dashboard version's errors.
+func dashboardVersionErrors(apiObject *awstypes.DashboardVersion) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	var output []string
+
+	for _, v := range apiObject.Errors {
+		description := fmt.Sprintf("%s: %s", v.Type, aws.ToString(v.Message))
+
+		var paths []string
+		for _, entity := range v.ViolatedEntities {
+			if path := aws.ToString(entity.Path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+
+		if len(paths) > 0 {
+			description += fmt.Sprintf(" (%s)", strings.Join(paths, ", "))
+		}
+
+		output = append(output, description)
+	}
+
+	return output
+}
+
 func findDashboardByThreePartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string, version int64) (*awstypes.Dashboard, error) {
 	input := &quicksight.DescribeDashboardInput{
 		AwsAccountId: aws.String(awsAccountID),
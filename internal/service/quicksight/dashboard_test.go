@@ -6,11 +6,17 @@ package quicksight_test
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,6 +25,103 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDashboardVersionErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		version  *awstypes.DashboardVersion
+		expected []string
+	}{
+		"nil": {},
+		"no errors": {
+			version: &awstypes.DashboardVersion{
+				Status: awstypes.ResourceStatusCreationSuccessful,
+			},
+		},
+		"errors": {
+			version: &awstypes.DashboardVersion{
+				Errors: []awstypes.DashboardError{
+					{
+						Message: aws.String("Column type mismatch"),
+						Type:    awstypes.DashboardErrorTypeColumnTypeMismatch,
+						ViolatedEntities: []awstypes.Entity{
+							{Path: aws.String("sheet/Test1/visual/LineChart")},
+							{Path: aws.String("sheet/Test1/visual/BarChart")},
+						},
+					},
+					{
+						Message: aws.String("Data set not found"),
+						Type:    awstypes.DashboardErrorTypeDataSetNotFound,
+					},
+				},
+				Status: awstypes.ResourceStatusCreationSuccessful,
+			},
+			expected: []string{
+				"COLUMN_TYPE_MISMATCH: Column type mismatch (sheet/Test1/visual/LineChart, sheet/Test1/visual/BarChart)",
+				"DATA_SET_NOT_FOUND: Data set not found",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfquicksight.DashboardVersionErrors(testCase.version), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("DashboardVersionErrors = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDashboardSourceModeChanged(t *testing.T) {
+	t.Parallel()
+
+	sourceEntity := []interface{}{map[string]interface{}{}}
+	sourceEntityARN := "arn:aws:quicksight:us-west-2:123456789012:template/example" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		oldSourceEntity []interface{}
+		newSourceEntity []interface{}
+		sourceEntityARN string
+		expected        bool
+	}{
+		"definition": {},
+		"source entity": {
+			oldSourceEntity: sourceEntity,
+			newSourceEntity: sourceEntity,
+			sourceEntityARN: sourceEntityARN,
+		},
+		"source entity to definition": {
+			oldSourceEntity: sourceEntity,
+			sourceEntityARN: sourceEntityARN,
+			expected:        true,
+		},
+		"definition to source entity": {
+			newSourceEntity: sourceEntity,
+			expected:        true,
+		},
+		"imported source entity": {
+			newSourceEntity: sourceEntity,
+			sourceEntityARN: sourceEntityARN,
+		},
+		"imported source entity to definition": {
+			sourceEntityARN: sourceEntityARN,
+			expected:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfquicksight.DashboardSourceModeChanged(testCase.oldSourceEntity, testCase.newSourceEntity, testCase.sourceEntityARN), testCase.expected; got != want {
+				t.Errorf("DashboardSourceModeChanged = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccQuickSightDashboard_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dashboard awstypes.Dashboard
@@ -116,6 +219,145 @@ func TestAccQuickSightDashboard_sourceEntity(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDashboard_sourceModeSwitch(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "source_entity.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_basic(rId, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "source_entity.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_sourceModeSwitchImported(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+				),
+			},
+			{
+				// The imported state has no source_entity, only the source_entity_arn of the published version.
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccDashboardConfig_basic(rId, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "source_entity.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_sourceEntityCrossRegionTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_crossRegionTemplateSourceEntity(rId, rName, sourceId, sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceStatusCreationSuccessful)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_entity.0.source_template.0.arn", "aws_quicksight_template.test", names.AttrARN),
+					resource.TestMatchResourceAttr(resourceName, "source_entity.0.source_template.0.arn", regexache.MustCompile(`:quicksight:`+acctest.AlternateRegion()+`:`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_sourceEntityInvalidTemplateARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardConfig_invalidTemplateARNSourceEntity(rId, rName),
+				ExpectError: regexache.MustCompile(`is not a QuickSight template ARN`),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightDashboard_updateVersionNumber(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dashboard awstypes.Dashboard
@@ -192,42 +434,378 @@ func TestAccQuickSightDashboard_dashboardSpecificConfig(t *testing.T) {
 	})
 }
 
-func testAccCheckDashboardDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
-
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_quicksight_dashboard" {
-				continue
-			}
+func TestAccQuickSightDashboard_sheetLayoutElementMaximization(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			_, err := tfquicksight.FindDashboardByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["dashboard_id"], tfquicksight.DashboardLatestVersion)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_sheetLayoutElementMaximization(rId, rName, string(awstypes.StatusEnabled), string(awstypes.StatusDisabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.sheet_layout_element_maximization_option.0.availability_status", string(awstypes.StatusEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.visual_menu_option.0.availability_status", string(awstypes.StatusDisabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDashboardConfig_sheetLayoutElementMaximization(rId, rName, string(awstypes.StatusDisabled), string(awstypes.StatusEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.sheet_layout_element_maximization_option.0.availability_status", string(awstypes.StatusDisabled)),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.visual_menu_option.0.availability_status", string(awstypes.StatusEnabled)),
+				),
+			},
+		},
+	})
+}
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+func TestAccQuickSightDashboard_exportWithHiddenFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			if err != nil {
-				return err
-			}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_exportWithHiddenFields(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_to_csv_option.0.availability_status", string(awstypes.DashboardBehaviorEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_with_hidden_fields_option.0.availability_status", string(awstypes.DashboardBehaviorEnabled)),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_exportWithHiddenFields(rId, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_with_hidden_fields_option.0.availability_status", string(awstypes.DashboardBehaviorEnabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
 
-			return fmt.Errorf("QuickSight Dashboard (%s) still exists", rs.Primary.ID)
-		}
+func TestAccQuickSightDashboard_danglingDataSetReference(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-		return nil
-	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardConfig_danglingDataSetReference(rId, rName),
+				ExpectError: regexache.MustCompile(`references QuickSight Data Set \(.+:dataset/` + rId + `-missing\) that does not exist`),
+			},
+		},
+	})
 }
 
-func testAccCheckDashboardExists(ctx context.Context, n string, v *awstypes.Dashboard) resource.TestCheckFunc {
-	return testAccCheckDashboardVersionExists(ctx, n, tfquicksight.DashboardLatestVersion, v)
+func TestAccQuickSightDashboard_undeclaredDataSetIdentifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardConfig_undeclaredDataSetIdentifier(rId, rName),
+				ExpectError: regexache.MustCompile(`sheet \(Test2\) references data set identifiers not declared in data_set_identifiers_declarations: 2`),
+			},
+		},
+	})
 }
 
-func testAccCheckDashboardVersionExists(ctx context.Context, n string, version int64, v *awstypes.Dashboard) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
+func TestAccQuickSightDashboard_parameterDeclarations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardConfig_parameterDeclarations(rId, rName, "2024-01-01", "[1]"),
+				ExpectError: regexache.MustCompile(`must be in RFC3339 time format`),
+			},
+			{
+				Config:      testAccDashboardConfig_parameterDeclarations(rId, rName, "2024-01-01T00:00:00Z", "[1, 2]"),
+				ExpectError: regexache.MustCompile(`integer_parameter_declaration parameter \(IntegerParam\) is SINGLE_VALUED but has 2 default static_values`),
+			},
+			{
+				Config: testAccDashboardConfig_parameterDeclarations(rId, rName, "2024-01-01T00:00:00Z", "[1]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.parameter_declarations.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "definition.0.parameter_declarations.*", map[string]string{
+						"date_time_parameter_declaration.0.name":                             "DateTimeParam",
+						"date_time_parameter_declaration.0.default_values.0.static_values.0": "2024-01-01T00:00:00Z",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_versionRetentionCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_versionRetentionCount(rId, rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_retention_count", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_versionRetentionCount(rId, rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+					testAccCheckDashboardVersions(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_versionRetentionCount(rId, rName, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct3),
+					testAccCheckDashboardVersions(ctx, resourceName, 3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_deleteVersionNumbers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_deleteVersionNumbers(rId, rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_deleteVersionNumbers(rId, rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+					testAccCheckDashboardVersions(ctx, resourceName, 1, 2),
+				),
+			},
+			{
+				// Deleting a single version keeps the dashboard and its published version.
+				Config: testAccDashboardConfig_deleteVersionNumbers(rId, rName, "second", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_numbers.#", acctest.Ct1),
+					testAccCheckDashboardVersions(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config:      testAccDashboardConfig_deleteVersionNumbers(rId, rName, "second", 1, 2),
+				ExpectError: regexache.MustCompile(`version \(2\) is the published version and can't be deleted`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_folderARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard awstypes.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	folder1ResourceName := "aws_quicksight_folder.test1"
+	folder2ResourceName := "aws_quicksight_folder.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_folderARNs(rId, rName, folder1ResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "folder_arns.*", folder1ResourceName, names.AttrARN),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder1ResourceName, true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDashboardConfig_folderARNs(rId, rName, folder1ResourceName+".arn", folder2ResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct2),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder1ResourceName, true),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder2ResourceName, true),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Removing a folder keeps the dashboard.
+				Config: testAccDashboardConfig_folderARNs(rId, rName, folder2ResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "folder_arns.*", folder2ResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder1ResourceName, false),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder2ResourceName, true),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Removing the last folder keeps the dashboard.
+				Config: testAccDashboardConfig_folderARNs(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder1ResourceName, false),
+					testAccCheckDashboardFolderMembership(ctx, resourceName, folder2ResourceName, false),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckDashboardDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_dashboard" {
+				continue
+			}
+
+			_, err := tfquicksight.FindDashboardByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["dashboard_id"], tfquicksight.DashboardLatestVersion)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("QuickSight Dashboard (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardExists(ctx context.Context, n string, v *awstypes.Dashboard) resource.TestCheckFunc {
+	return testAccCheckDashboardVersionExists(ctx, n, tfquicksight.DashboardLatestVersion, v)
+}
+
+func testAccCheckDashboardVersionExists(ctx context.Context, n string, version int64, v *awstypes.Dashboard) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
 
@@ -243,6 +821,66 @@ func testAccCheckDashboardVersionExists(ctx context.Context, n string, version i
 	}
 }
 
+func testAccCheckDashboardVersions(ctx context.Context, n string, want ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		got, err := tfquicksight.FindDashboardVersionNumbers(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["dashboard_id"])
+
+		if err != nil {
+			return err
+		}
+
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("QuickSight Dashboard (%s) versions = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDashboardFolderMembership checks whether the folder lists the dashboard as a member.
+func testAccCheckDashboardFolderMembership(ctx context.Context, n, folder string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		folderRS, ok := s.RootModule().Resources[folder]
+		if !ok {
+			return fmt.Errorf("Not found: %s", folder)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := tfquicksight.FindFolderMembershipByFourPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], folderRS.Primary.Attributes["folder_id"], string(awstypes.MemberTypeDashboard), rs.Primary.Attributes["dashboard_id"])
+
+		if tfresource.NotFound(err) {
+			if want {
+				return fmt.Errorf("QuickSight Dashboard (%s) is not in folder %s", rs.Primary.ID, folderRS.Primary.ID)
+			}
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !want {
+			return fmt.Errorf("QuickSight Dashboard (%s) is still in folder %s", rs.Primary.ID, folderRS.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccDashboardConfig_base(rId string, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfig_base(rId, rName),
@@ -358,27 +996,20 @@ resource "aws_quicksight_dashboard" "test" {
 `, rId, rName))
 }
 
-func testAccDashboardConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
+func testAccDashboardConfig_versionRetentionCount(rId, rName, versionDescription string) string {
 	return acctest.ConfigCompose(
 		testAccDashboardConfig_base(rId, rName),
 		fmt.Sprintf(`
-resource "aws_quicksight_template" "test" {
-  template_id         = %[3]q
-  name                = %[4]q
-  version_description = "test"
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id            = %[1]q
+  name                    = %[2]q
+  version_description     = %[3]q
+  version_retention_count = 1
+
   definition {
-    data_set_configuration {
-      data_set_schema {
-        column_schema_list {
-          name      = "Column1"
-          data_type = "STRING"
-        }
-        column_schema_list {
-          name      = "Column2"
-          data_type = "INTEGER"
-        }
-      }
-      placeholder = "1"
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
     }
     sheets {
       title    = "Test"
@@ -394,18 +1025,369 @@ resource "aws_quicksight_template" "test" {
           visual_id = "Test1"
         }
       }
-      visuals {
-        line_chart_visual {
-          visual_id = "LineChart"
-          title {
-            format_text {
-              plain_text = "Line Chart Test"
-            }
-          }
-          chart_configuration {
-            field_wells {
-              line_chart_aggregated_field_wells {
-                category {
+    }
+  }
+}
+`, rId, rName, versionDescription))
+}
+
+func testAccDashboardConfig_deleteVersionNumbers(rId, rName, versionDescription string, versionNumbers ...int) string {
+	var numbers []string
+	for _, v := range versionNumbers {
+		numbers = append(numbers, strconv.Itoa(v))
+	}
+
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id           = %[1]q
+  name                   = %[2]q
+  version_description    = %[3]q
+  delete_version_numbers = [%[4]s]
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName, versionDescription, strings.Join(numbers, ", ")))
+}
+
+func testAccDashboardConfig_folderARNs(rId, rName string, folderARNs ...string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_folder" "test1" {
+  folder_id = "%[1]s-1"
+  name      = "%[2]s-1"
+}
+
+resource "aws_quicksight_folder" "test2" {
+  folder_id = "%[1]s-2"
+  name      = "%[2]s-2"
+}
+
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  folder_arns         = [%[3]s]
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName, strings.Join(folderARNs, ", ")))
+}
+
+func testAccDashboardConfig_sheetLayoutElementMaximization(rId, rName, maximization, visualMenu string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+
+  dashboard_publish_options {
+    sheet_layout_element_maximization_option {
+      availability_status = %[3]q
+    }
+    visual_menu_option {
+      availability_status = %[4]q
+    }
+  }
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName, maximization, visualMenu))
+}
+
+func testAccDashboardConfig_exportWithHiddenFields(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  dashboard_publish_options {
+    export_to_csv_option {
+      availability_status = "ENABLED"
+    }
+    export_with_hidden_fields_option {
+      availability_status = "ENABLED"
+    }
+  }
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccDashboardConfig_danglingDataSetReference(rId, rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = "arn:${data.aws_partition.current.partition}:quicksight:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:dataset/%[1]s-missing"
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName)
+}
+
+func testAccDashboardConfig_undeclaredDataSetIdentifier(rId, rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = "arn:${data.aws_partition.current.partition}:quicksight:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:dataset/%[1]s"
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test2"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "2"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test2"
+        }
+      }
+    }
+  }
+}
+`, rId, rName)
+}
+
+func testAccDashboardConfig_parameterDeclarations(rId, rName, dateTimeDefault, integerDefaults string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    parameter_declarations {
+      date_time_parameter_declaration {
+        name             = "DateTimeParam"
+        time_granularity = "DAY"
+        default_values {
+          static_values = [%[3]q]
+        }
+      }
+    }
+    parameter_declarations {
+      integer_parameter_declaration {
+        name                 = "IntegerParam"
+        parameter_value_type = "SINGLE_VALUED"
+        default_values {
+          static_values = %[4]s
+        }
+      }
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+`, rId, rName, dateTimeDefault, integerDefaults))
+}
+
+func testAccDashboardConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[3]q
+  name                = %[4]q
+  version_description = "test"
+  definition {
+    data_set_configuration {
+      data_set_schema {
+        column_schema_list {
+          name      = "Column1"
+          data_type = "STRING"
+        }
+        column_schema_list {
+          name      = "Column2"
+          data_type = "INTEGER"
+        }
+      }
+      placeholder = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+      visuals {
+        line_chart_visual {
+          visual_id = "LineChart"
+          title {
+            format_text {
+              plain_text = "Line Chart Test"
+            }
+          }
+          chart_configuration {
+            field_wells {
+              line_chart_aggregated_field_wells {
+                category {
                   categorical_dimension_field {
                     field_id = "1"
                     column {
@@ -450,6 +1432,83 @@ resource "aws_quicksight_dashboard" "test" {
 `, rId, rName, sourceId, sourceName))
 }
 
+func testAccDashboardConfig_crossRegionTemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  provider = "awsalternate"
+
+  template_id         = %[3]q
+  name                = %[4]q
+  version_description = "test"
+  definition {
+    data_set_configuration {
+      data_set_schema {
+        column_schema_list {
+          name      = "Column1"
+          data_type = "STRING"
+        }
+      }
+      placeholder = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  source_entity {
+    source_template {
+      arn = aws_quicksight_template.test.arn
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = "1"
+      }
+    }
+  }
+}
+`, rId, rName, sourceId, sourceName))
+}
+
+func testAccDashboardConfig_invalidTemplateARNSourceEntity(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  source_entity {
+    source_template {
+      arn = "arn:%[3]s:quicksight:%[4]s:123456789012:analysis/%[1]s"
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = "1"
+      }
+    }
+  }
+}
+`, rId, rName, acctest.Partition(), acctest.Region()))
+}
+
 func testAccDashboardConfig_DashboardSpecificConfig(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDashboardConfig_base(rId, rName),
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -65,11 +66,16 @@ func resourceDataSet() *schema.Resource {
 				},
 				"data_set_usage_configuration": quicksightschema.DataSetUsageConfigurationSchema(),
 				"field_folders":                quicksightschema.DataSetFieldFoldersSchema(),
+				"folder_arns":                  folderARNsSchema(),
 				"import_mode": {
 					Type:             schema.TypeString,
 					Required:         true,
 					ValidateDiagFunc: enum.Validate[awstypes.DataSetImportMode](),
 				},
+				"last_ingestion_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"logical_table_map": quicksightschema.DataSetLogicalTableMapSchema(),
 				names.AttrName: {
 					Type:         schema.TypeString,
@@ -95,6 +101,13 @@ func resourceDataSet() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				return quicksightschema.ValidatePhysicalTableMap(diff.Get("physical_table_map").(*schema.Set).List())
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				return quicksightschema.ValidateLookbackWindowColumn(diff.Get("refresh_properties").([]interface{}), diff.Get("physical_table_map").(*schema.Set).List(), diff.Get("logical_table_map").(*schema.Set).List())
+			},
+			folderARNsCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -135,6 +148,10 @@ func resourceDataSetCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.FieldFolders = quicksightschema.ExpandFieldFolders(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("folder_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FolderArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() != 0 {
 		input.LogicalTableMap = quicksightschema.ExpandLogicalTableMap(v.(*schema.Set).List())
 	}
@@ -166,6 +183,10 @@ func resourceDataSetCreate(ctx context.Context, d *schema.ResourceData, meta int
 			DataSetRefreshProperties: quicksightschema.ExpandDataSetRefreshProperties(v.([]interface{})),
 		}
 
+		if err := checkDataSetLookbackWindowColumn(ctx, conn, awsAccountID, dataSetID, input.DataSetRefreshProperties); err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting QuickSight Data Set (%s) refresh properties: %s", d.Id(), err)
+		}
+
 		_, err := conn.PutDataSetRefreshProperties(ctx, input)
 
 		if err != nil {
@@ -230,6 +251,35 @@ func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting row_level_permission_tag_configuration: %s", err)
 	}
 
+	if folderARNsInUse(d) {
+		folderARNs, err := findFolderARNsForResource(ctx, conn, awsAccountID, aws.ToString(dataSet.Arn))
+
+		switch {
+		case isFolderListingUnavailableError(err):
+			diags = sdkdiag.AppendWarningf(diags, "reading QuickSight Data Set (%s) folders: %s", d.Id(), err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading QuickSight Data Set (%s) folders: %s", d.Id(), err)
+		default:
+			d.Set("folder_arns", folderARNs)
+		}
+	}
+
+	// DescribeDataSet does not report ingestion failures, so surface the status of the most recent SPICE ingestion.
+	if dataSet.ImportMode == awstypes.DataSetImportModeSpice {
+		ingestion, err := findLatestIngestionByTwoPartKey(ctx, conn, awsAccountID, dataSetID)
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("last_ingestion_status", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading QuickSight Data Set (%s) ingestions: %s", d.Id(), err)
+		default:
+			d.Set("last_ingestion_status", ingestion.IngestionStatus)
+		}
+	} else {
+		d.Set("last_ingestion_status", nil)
+	}
+
 	permissions, err := findDataSetPermissionsByTwoPartKey(ctx, conn, awsAccountID, dataSetID)
 
 	if err != nil {
@@ -244,6 +294,8 @@ func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	switch {
 	case tfresource.NotFound(err):
+		// Refresh properties removed out-of-band (DeleteDataSetRefreshProperties).
+		d.Set("refresh_properties", nil)
 	case err != nil:
 		return sdkdiag.AppendErrorf(diags, "reading QuickSight Data Set (%s) refresh properties: %s", d.Id(), err)
 	default:
@@ -264,7 +316,7 @@ func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "refresh_properties") {
+	if d.HasChangesExcept("folder_arns", names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "refresh_properties") {
 		input := &quicksight.UpdateDataSetInput{
 			AwsAccountId:                       aws.String(awsAccountID),
 			ColumnGroups:                       quicksightschema.ExpandColumnGroups(d.Get("column_groups").([]interface{})),
@@ -287,6 +339,14 @@ func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("folder_arns") {
+		o, n := d.GetChange("folder_arns")
+
+		if err := updateFolderMemberships(ctx, conn, awstypes.MemberTypeDataset, dataSetID, flex.ExpandStringValueSet(o.(*schema.Set)), flex.ExpandStringValueSet(n.(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Data Set (%s) folders: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrPermissions) {
 		o, n := d.GetChange(names.AttrPermissions)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
@@ -323,7 +383,7 @@ func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 			_, err := conn.DeleteDataSetRefreshProperties(ctx, input)
 
-			if err != nil {
+			if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
 				return sdkdiag.AppendErrorf(diags, "deleting QuickSight Data Set (%s) refresh properties: %s", d.Id(), err)
 			}
 		} else {
@@ -333,6 +393,10 @@ func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				DataSetRefreshProperties: quicksightschema.ExpandDataSetRefreshProperties(new),
 			}
 
+			if err := checkDataSetLookbackWindowColumn(ctx, conn, awsAccountID, dataSetID, input.DataSetRefreshProperties); err != nil {
+				return sdkdiag.AppendErrorf(diags, "putting QuickSight Data Set (%s) refresh properties: %s", d.Id(), err)
+			}
+
 			_, err = conn.PutDataSetRefreshProperties(ctx, input)
 
 			if err != nil {
@@ -419,6 +483,37 @@ func findDataSet(ctx context.Context, conn *quicksight.Client, input *quicksight
 	return output.DataSet, nil
 }
 
+// checkDataSetLookbackWindowColumn verifies against the data set's output columns that the incremental refresh
+// lookback window column is a DATETIME column. This covers columns whose type couldn't be checked at plan time.
+func checkDataSetLookbackWindowColumn(ctx context.Context, conn *quicksight.Client, awsAccountID, dataSetID string, apiObject *awstypes.DataSetRefreshProperties) error {
+	if apiObject == nil || apiObject.RefreshConfiguration == nil || apiObject.RefreshConfiguration.IncrementalRefresh == nil || apiObject.RefreshConfiguration.IncrementalRefresh.LookbackWindow == nil {
+		return nil
+	}
+
+	columnName := aws.ToString(apiObject.RefreshConfiguration.IncrementalRefresh.LookbackWindow.ColumnName)
+
+	dataSet, err := findDataSetByTwoPartKey(ctx, conn, awsAccountID, dataSetID)
+
+	if err != nil {
+		return fmt.Errorf("reading QuickSight Data Set (%s) output columns: %w", dataSetID, err)
+	}
+
+	for _, v := range dataSet.OutputColumns {
+		if aws.ToString(v.Name) != columnName {
+			continue
+		}
+
+		if v.Type != awstypes.ColumnDataTypeDatetime {
+			return fmt.Errorf("lookback_window column_name (%s) must be a %s column, got %s", columnName, awstypes.ColumnDataTypeDatetime, v.Type)
+		}
+
+		return nil
+	}
+
+	// Unknown columns are reported by PutDataSetRefreshProperties.
+	return nil
+}
+
 func findDataSetRefreshPropertiesByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, dataSetID string) (*awstypes.DataSetRefreshProperties, error) {
 	input := &quicksight.DescribeDataSetRefreshPropertiesInput{
 		AwsAccountId: aws.String(awsAccountID),
@@ -52,6 +52,7 @@ func dataSourceDataSet() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"output_columns":                         quicksightschema.DataSetOutputColumnsSchema(),
 				names.AttrPermissions:                    quicksightschema.PermissionsDataSourceSchema(),
 				"physical_table_map":                     quicksightschema.DataSetPhysicalTableMapSchemaDataSourceSchema(),
 				"row_level_permission_data_set":          quicksightschema.DataSetRowLevelPermissionDataSetSchemaDataSourceSchema(),
@@ -107,6 +108,9 @@ func dataSourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting logical_table_map: %s", err)
 	}
 	d.Set(names.AttrName, dataSet.Name)
+	if err := d.Set("output_columns", quicksightschema.FlattenOutputColumns(dataSet.OutputColumns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_columns: %s", err)
+	}
 	if err := d.Set("physical_table_map", quicksightschema.FlattenPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting physical_table_map: %s", err)
 	}
@@ -31,6 +31,9 @@ func TestAccQuickSightDataSetDataSource_basic(t *testing.T) {
 				Config: testAccDataSetDataSourceConfig_basic(rId, rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_columns.#", resourceName, "output_columns.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_columns.0.name", "Column1"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_columns.0.type", "STRING"),
 				),
 			},
 		},
@@ -11,9 +11,12 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -48,8 +51,15 @@ func TestAccQuickSightDataSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.s3_source.0.input_columns.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.s3_source.0.input_columns.0.name", "Column1"),
 					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.s3_source.0.input_columns.0.type", "STRING"),
+					resource.TestCheckResourceAttr(resourceName, "output_columns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output_columns.0.name", "Column1"),
+					resource.TestCheckResourceAttr(resourceName, "output_columns.0.type", "STRING"),
 				),
 			},
+			{
+				Config:   testAccDataSetConfigBasic(rId, rName),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -209,6 +219,69 @@ func TestAccQuickSightDataSet_fieldFolders(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDataSet_folderARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	folder1ResourceName := "aws_quicksight_folder.test1"
+	folder2ResourceName := "aws_quicksight_folder.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// A folder ID instead of an ARN.
+				Config:      testAccDataSetConfigFolderARNs(rId, rName, fmt.Sprintf("%q", rId+"-1")),
+				ExpectError: regexache.MustCompile(`arn: invalid prefix`),
+			},
+			{
+				Config: testAccDataSetConfigFolderARNs(rId, rName, folder1ResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "folder_arns.*", folder1ResourceName, names.AttrARN),
+					testAccCheckDataSetFolderMembership(ctx, resourceName, folder1ResourceName, true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSetConfigFolderARNs(rId, rName, folder2ResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "folder_arns.*", folder2ResourceName, names.AttrARN),
+					testAccCheckDataSetFolderMembership(ctx, resourceName, folder1ResourceName, false),
+					testAccCheckDataSetFolderMembership(ctx, resourceName, folder2ResourceName, true),
+				),
+			},
+			{
+				// Removing the last folder keeps the data set.
+				Config: testAccDataSetConfigFolderARNs(rId, rName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct0),
+					testAccCheckDataSetFolderMembership(ctx, resourceName, folder1ResourceName, false),
+					testAccCheckDataSetFolderMembership(ctx, resourceName, folder2ResourceName, false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightDataSet_logicalTableMap(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dataSet awstypes.DataSet
@@ -261,6 +334,39 @@ func TestAccQuickSightDataSet_logicalTableMap(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDataSet_tagColumnOperation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigTagColumnOperation(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.0.tag_column_operation.0.column_name", "Column1"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.0.tag_column_operation.0.tags.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.0.tag_column_operation.0.tags.0.column_geographic_role", "COUNTRY"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.0.tag_column_operation.0.tags.1.column_description.0.text", "Country of sale"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccQuickSightDataSet_permissions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dataSet awstypes.DataSet
@@ -323,7 +429,63 @@ func TestAccQuickSightDataSet_permissions(t *testing.T) {
 				Config: testAccDataSetConfigBasic(rId, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
-					resource.TestCheckResourceAttr(resourceName, "permission.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_permissionsGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigPermissions(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct1),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]*regexp.Regexp{
+						names.AttrPrincipal: regexache.MustCompile(fmt.Sprintf(`user/default/%s`, rName)),
+					}),
+				),
+			},
+			{
+				Config: testAccDataSetConfigPermissionsGroup(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct2),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]*regexp.Regexp{
+						names.AttrPrincipal: regexache.MustCompile(fmt.Sprintf(`user/default/%s`, rName)),
+					}),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]*regexp.Regexp{
+						names.AttrPrincipal: regexache.MustCompile(fmt.Sprintf(`group/default/%s`, rName)),
+						"actions.#":         regexache.MustCompile(`^2$`),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSetConfigPermissions(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct1),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]*regexp.Regexp{
+						names.AttrPrincipal: regexache.MustCompile(fmt.Sprintf(`user/default/%s`, rName)),
+					}),
 				),
 			},
 		},
@@ -402,6 +564,47 @@ func TestAccQuickSightDataSet_rowLevelPermissionTagConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDataSet_rowLevelPermissionDataSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rlsResourceName := "aws_quicksight_data_set.rls"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigRowLevelPermissionDataSet(rId, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_data_set.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "row_level_permission_data_set.0.arn", rlsResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_data_set.0.format_version", string(awstypes.RowLevelPermissionFormatVersionVersion1)),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_data_set.0.permission_policy", string(awstypes.RowLevelPermissionPolicyGrantAccess)),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_data_set.0.status", string(awstypes.StatusEnabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSetConfigRowLevelPermissionDataSet(rId, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_data_set.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightDataSet_refreshProperties(t *testing.T) {
 	ctx := acctest.Context(t)
 	// This test requires additional configuration of the QuickSight service role. Ensure
@@ -447,8 +650,13 @@ func TestAccQuickSightDataSet_refreshProperties(t *testing.T) {
 	})
 }
 
-func TestAccQuickSightDataSet_noPhysicalTableMap(t *testing.T) {
+func TestAccQuickSightDataSet_refreshPropertiesDisappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	// See TestAccQuickSightDataSet_refreshProperties for the required service role configuration.
+	if os.Getenv("QUICKSIGHT_ATHENA_TESTING_ENABLED") == "" {
+		t.Skip("Environment variable QUICKSIGHT_ATHENA_TESTING_ENABLED is not set")
+	}
+
 	var dataSet awstypes.DataSet
 	resourceName := "aws_quicksight_data_set.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -461,70 +669,328 @@ func TestAccQuickSightDataSet_noPhysicalTableMap(t *testing.T) {
 		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataSetConfigNoPhysicalTableMap(rId, rName),
+				Config: testAccDataSetConfigRefreshProperties(rId, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
-					resource.TestCheckResourceAttr(resourceName, "data_set_id", rId),
-					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "quicksight", fmt.Sprintf("dataset/%s", rId)),
-					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
-					resource.TestCheckResourceAttr(resourceName, "import_mode", "SPICE"),
-					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", acctest.Ct3),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.logical_table_map_id", "joined"),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.alias", "j"),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.right_operand", "right"),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.left_operand", "left"),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.type", "INNER"),
-					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.on_clause", "Column1 = Column2"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_properties.#", acctest.Ct1),
+					testAccCheckDataSetRefreshPropertiesDisappears(ctx, resourceName),
 				),
+				ExpectNonEmptyPlan: true,
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccDataSetConfigRefreshProperties(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "refresh_properties.#", acctest.Ct1),
+				),
 			},
 		},
 	})
 }
 
-func testAccCheckDataSetExists(ctx context.Context, n string, v *awstypes.DataSet) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
-
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
-
-		output, err := tfquicksight.FindDataSetByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["data_set_id"])
-
-		if err != nil {
-			return err
-		}
-
-		*v = *output
+func TestAccQuickSightDataSet_refreshPropertiesLookbackColumnNotDatetime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-		return nil
-	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSetConfigRefreshPropertiesLookbackColumnNotDatetime(rId, rName),
+				ExpectError: regexache.MustCompile(`lookback_window column_name \(Column1\) must be a DATETIME column, got STRING`),
+			},
+		},
+	})
 }
 
-func testAccCheckDataSetDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+func TestAccQuickSightDataSet_multiplePhysicalTables(t *testing.T) {
+	ctx := acctest.Context(t)
+	// See TestAccQuickSightDataSet_refreshProperties for the required service role configuration.
+	if os.Getenv("QUICKSIGHT_ATHENA_TESTING_ENABLED") == "" {
+		t.Skip("Environment variable QUICKSIGHT_ATHENA_TESTING_ENABLED is not set")
+	}
 
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_quicksight_data_set" {
-				continue
-			}
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			_, err := tfquicksight.FindDataSetByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["data_set_id"])
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigMultiplePhysicalTables(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "physical_table_map.*", map[string]string{
+						"physical_table_map_id": "relational",
+						"relational_table.#":    acctest.Ct1,
+						"custom_sql.#":          acctest.Ct0,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "physical_table_map.*", map[string]string{
+						"physical_table_map_id": "customsql",
+						"custom_sql.#":          acctest.Ct1,
+						"relational_table.#":    acctest.Ct0,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "logical_table_map.*", map[string]string{
+						"logical_table_map_id":                      "joined",
+						"source.0.join_instruction.#":               acctest.Ct1,
+						"source.0.join_instruction.0.left_operand":  "left",
+						"source.0.join_instruction.0.right_operand": "right",
+						"source.0.join_instruction.0.type":          "INNER",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+func TestAccQuickSightDataSet_physicalTableMapMultipleSources(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			if err != nil {
-				return err
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSetConfigPhysicalTableMapMultipleSources(rId, rName),
+				ExpectError: regexache.MustCompile(`exactly one of custom_sql, relational_table or s3_source must be configured`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_customSQL(t *testing.T) {
+	ctx := acctest.Context(t)
+	// See TestAccQuickSightDataSet_refreshProperties for the required service role configuration.
+	if os.Getenv("QUICKSIGHT_ATHENA_TESTING_ENABLED") == "" {
+		t.Skip("Environment variable QUICKSIGHT_ATHENA_TESTING_ENABLED is not set")
+	}
+
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigCustomSQL(rId, rName, "SELECT 'a' AS col_string, 1 AS col_integer, 1.5 AS col_decimal"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "physical_table_map.0.custom_sql.0.data_source_arn", "aws_quicksight_data_source.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.0.name", "col_string"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.0.type", "STRING"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.1.name", "col_integer"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.1.type", "INTEGER"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.2.name", "col_decimal"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.0.custom_sql.0.columns.2.type", "DECIMAL"),
+				),
+			},
+			{
+				Config:   testAccDataSetConfigCustomSQL(rId, rName, "SELECT 'a' AS col_string, 1 AS col_integer, 1.5 AS col_decimal"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_customSQLEmptyQuery(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSetConfigCustomSQL(rId, rName, "   "),
+				ExpectError: regexache.MustCompile(`sql_query" to not be an empty string or whitespace`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_noPhysicalTableMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSet awstypes.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigNoPhysicalTableMap(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "data_set_id", rId),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "quicksight", fmt.Sprintf("dataset/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "import_mode", "SPICE"),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.logical_table_map_id", "joined"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.alias", "j"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.right_operand", "right"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.left_operand", "left"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.type", "INNER"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.source.0.join_instruction.0.on_clause", "Column1 = Column2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataSetExists(ctx context.Context, n string, v *awstypes.DataSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		output, err := tfquicksight.FindDataSetByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["data_set_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDataSetRefreshPropertiesDisappears(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := conn.DeleteDataSetRefreshProperties(ctx, &quicksight.DeleteDataSetRefreshPropertiesInput{
+			AwsAccountId: aws.String(rs.Primary.Attributes[names.AttrAWSAccountID]),
+			DataSetId:    aws.String(rs.Primary.Attributes["data_set_id"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindDataSetRefreshPropertiesByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["data_set_id"])
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Data Set (%s) refresh properties still exist", rs.Primary.ID)
+	}
+}
+
+// testAccCheckDataSetFolderMembership checks whether the folder lists the data set as a member.
+func testAccCheckDataSetFolderMembership(ctx context.Context, n, folder string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		folderRS, ok := s.RootModule().Resources[folder]
+		if !ok {
+			return fmt.Errorf("Not found: %s", folder)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := tfquicksight.FindFolderMembershipByFourPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], folderRS.Primary.Attributes["folder_id"], string(awstypes.MemberTypeDataset), rs.Primary.Attributes["data_set_id"])
+
+		if tfresource.NotFound(err) {
+			if want {
+				return fmt.Errorf("QuickSight Data Set (%s) is not in folder %s", rs.Primary.ID, folderRS.Primary.ID)
+			}
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !want {
+			return fmt.Errorf("QuickSight Data Set (%s) is still in folder %s", rs.Primary.ID, folderRS.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_data_set" {
+				continue
+			}
+
+			_, err := tfquicksight.FindDataSetByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["data_set_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
 			}
 
 			return fmt.Errorf("QuickSight Data Set (%s) still exists", rs.Primary.ID)
@@ -582,6 +1048,43 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
+func testAccDataSetConfigFolderARNs(rId, rName, folderARN string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_folder" "test1" {
+  folder_id = "%[1]s-1"
+  name      = "%[2]s-1"
+}
+
+resource "aws_quicksight_folder" "test2" {
+  folder_id = "%[1]s-2"
+  name      = "%[2]s-2"
+}
+
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+  folder_arns = [%[3]s]
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+}
+`, rId, rName, folderARN))
+}
+
 func testAccDataSetConfigColumnGroups(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfig_base(rId, rName),
@@ -753,6 +1256,50 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
+func testAccDataSetConfigTagColumnOperation(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {}
+    }
+  }
+  logical_table_map {
+    logical_table_map_id = %[1]q
+    alias                = "Group1"
+    source {
+      physical_table_id = %[1]q
+    }
+    data_transforms {
+      tag_column_operation {
+        column_name = "Column1"
+        tags {
+          column_geographic_role = "COUNTRY"
+        }
+        tags {
+          column_description {
+            text = "Country of sale"
+          }
+        }
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
 func testAccDataSetConfigUpdateLogicalTableMap(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfig_base(rId, rName),
@@ -830,12 +1377,60 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
-func testAccDataSetConfigUpdatePermissions(rId, rName string) string {
+func testAccDataSetConfigPermissionsGroup(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfig_base(rId, rName),
 		testAccDataSource_UserConfig(rName),
 		fmt.Sprintf(`
-resource "aws_quicksight_data_set" "test" {
+resource "aws_quicksight_group" "test" {
+  group_name = %[2]q
+}
+
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+  permissions {
+    actions = [
+      "quicksight:DescribeDataSet",
+      "quicksight:DescribeDataSetPermissions",
+      "quicksight:PassDataSet",
+      "quicksight:DescribeIngestion",
+      "quicksight:ListIngestions",
+    ]
+    principal = aws_quicksight_user.test.arn
+  }
+  permissions {
+    actions = [
+      "quicksight:DescribeDataSet",
+      "quicksight:PassDataSet",
+    ]
+    principal = aws_quicksight_group.test.arn
+  }
+}
+`, rId, rName))
+}
+
+func testAccDataSetConfigUpdatePermissions(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_base(rId, rName),
+		testAccDataSource_UserConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
   data_set_id = %[1]q
   name        = %[2]q
   import_mode = "SPICE"
@@ -948,6 +1543,103 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
+func testAccDataSetConfigRowLevelPermissionDataSet(rId, rName string, attach bool) string {
+	var rls string
+	if attach {
+		rls = `
+  row_level_permission_data_set {
+    arn               = aws_quicksight_data_set.rls.arn
+    permission_policy = "GRANT_ACCESS"
+  }
+`
+	}
+
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "rls" {
+  data_set_id = "%[1]s-rls"
+  name        = "%[2]s-rls"
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = "%[1]s-rls"
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "GroupName"
+        type = "STRING"
+      }
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+}
+
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+%[3]s}
+`, rId, rName, rls))
+}
+
+func testAccDataSetConfigRefreshPropertiesLookbackColumnNotDatetime(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+  refresh_properties {
+    refresh_configuration {
+      incremental_refresh {
+        lookback_window {
+          column_name = "Column1"
+          size        = 1
+          size_unit   = "DAY"
+        }
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
 func testAccDataSetConfigRefreshProperties(rId, rName string) string {
 	// NOTE: Must use Athena data source here as incremental refresh is not supported by S3
 	return acctest.ConfigCompose(
@@ -1033,6 +1725,201 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
+func testAccDataSetConfigMultiplePhysicalTables(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[2]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[2]q
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  parameters = {
+    EXTERNAL       = "TRUE"
+    classification = "json"
+  }
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.id}/data/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      name                  = "jsonserde"
+      serialization_library = "org.openx.data.jsonserde.JsonSerDe"
+      parameters = {
+        "serialization.format" = "1"
+      }
+    }
+    columns {
+      name = "column1"
+      type = "string"
+    }
+    columns {
+      name = "column2"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+  type           = "ATHENA"
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+  ssl_properties {
+    disable_ssl = false
+  }
+}
+
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = "relational"
+    relational_table {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      catalog         = "AwsDataCatalog"
+      schema          = aws_glue_catalog_database.test.name
+      name            = aws_glue_catalog_table.test.name
+      input_columns {
+        name = "column1"
+        type = "STRING"
+      }
+    }
+  }
+  physical_table_map {
+    physical_table_map_id = "customsql"
+    custom_sql {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      name            = "customsql"
+      sql_query       = "SELECT column1 AS key, column2 FROM \"${aws_glue_catalog_database.test.name}\".\"${aws_glue_catalog_table.test.name}\""
+      columns {
+        name = "key"
+        type = "STRING"
+      }
+      columns {
+        name = "column2"
+        type = "STRING"
+      }
+    }
+  }
+  logical_table_map {
+    logical_table_map_id = "left"
+    alias                = "left"
+    source {
+      physical_table_id = "relational"
+    }
+  }
+  logical_table_map {
+    logical_table_map_id = "right"
+    alias                = "right"
+    source {
+      physical_table_id = "customsql"
+    }
+  }
+  logical_table_map {
+    logical_table_map_id = "joined"
+    alias                = "joined"
+    source {
+      join_instruction {
+        left_operand  = "left"
+        right_operand = "right"
+        type          = "INNER"
+        on_clause     = "column1 = key"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccDataSetConfigPhysicalTableMapMultipleSources(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    custom_sql {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      name            = "test"
+      sql_query       = "SELECT 1"
+    }
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {}
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccDataSetConfigCustomSQL(rId, rName, sqlQuery string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+  type           = "ATHENA"
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+  ssl_properties {
+    disable_ssl = false
+  }
+}
+
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    custom_sql {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      name            = %[2]q
+      sql_query       = %[3]q
+      columns {
+        name = "col_string"
+        type = "STRING"
+      }
+      columns {
+        name = "col_integer"
+        type = "INTEGER"
+      }
+      columns {
+        name = "col_decimal"
+        type = "DECIMAL"
+      }
+    }
+  }
+}
+`, rId, rName, sqlQuery))
+}
+
 func testAccDataSetConfigNoPhysicalTableMap(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfig_base(rId, rName),
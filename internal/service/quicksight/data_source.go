@@ -5,6 +5,7 @@ package quicksight
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -62,6 +65,7 @@ func resourceDataSource() *schema.Resource {
 					Required: true,
 					ForceNew: true,
 				},
+				"folder_arns": folderARNsSchema(),
 				names.AttrName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -85,7 +89,12 @@ func resourceDataSource() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			dataSourceAlternateParametersCustomizeDiff,
+			dataSourceRedshiftParametersCustomizeDiff,
+			folderARNsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -112,6 +121,10 @@ func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Credentials = quicksightschema.ExpandDataSourceCredentials(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("folder_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FolderArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() != 0 {
 		input.Permissions = quicksightschema.ExpandResourcePermissions(v.(*schema.Set).List())
 	}
@@ -162,6 +175,9 @@ func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.Set(names.AttrARN, dataSource.Arn)
 	d.Set(names.AttrAWSAccountID, awsAccountID)
+	if err := d.Set("credentials", flattenDataSourceCredentials(dataSource.SecretArn, d.Get("credentials").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting credentials: %s", err)
+	}
 	d.Set("data_source_id", dataSource.DataSourceId)
 	d.Set(names.AttrName, dataSource.Name)
 	if err := d.Set(names.AttrParameters, quicksightschema.FlattenDataSourceParameters(dataSource.DataSourceParameters)); err != nil {
@@ -175,6 +191,24 @@ func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "setting vpc_connection_properties: %s", err)
 	}
 
+	// Failed data sources still exist, so surface the broken connection without failing the read.
+	if err := dataSourceFailedStatusError(dataSource); err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "QuickSight Data Source (%s) status is %s: %s", d.Id(), dataSource.Status, err)
+	}
+
+	if folderARNsInUse(d) {
+		folderARNs, err := findFolderARNsForResource(ctx, conn, awsAccountID, aws.ToString(dataSource.Arn))
+
+		switch {
+		case isFolderListingUnavailableError(err):
+			diags = sdkdiag.AppendWarningf(diags, "reading QuickSight Data Source (%s) folders: %s", d.Id(), err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading QuickSight Data Source (%s) folders: %s", d.Id(), err)
+		default:
+			d.Set("folder_arns", folderARNs)
+		}
+	}
+
 	permissions, err := findDataSourcePermissionsByTwoPartKey(ctx, conn, awsAccountID, dataSourceID)
 
 	if err != nil {
@@ -188,6 +222,54 @@ func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// dataSourceAlternateParametersCustomizeDiff verifies that the credential pair's alternate data source parameters
+// are of the same type as the primary parameters, which QuickSight requires to share the credentials.
+func dataSourceAlternateParametersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	alternates, ok := d.Get("credentials.0.credential_pair.0.alternate_data_source_parameters").([]interface{})
+	if !ok || len(alternates) == 0 {
+		return nil
+	}
+
+	if err := quicksightschema.ValidateAlternateDataSourceParameters(d.Get(names.AttrParameters).([]interface{}), alternates); err != nil {
+		return fmt.Errorf("credentials.0.credential_pair.0.%w", err)
+	}
+
+	return nil
+}
+
+// dataSourceRedshiftParametersCustomizeDiff verifies how the primary and each alternate redshift parameters identify
+// the cluster endpoint.
+func dataSourceRedshiftParametersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return quicksightschema.ValidateDataSourceRedshiftParameters(d.GetRawConfig())
+}
+
+// flattenDataSourceCredentials reconciles the configured credentials with the secret ARN returned by the API.
+// Credential pairs and copy source ARNs aren't returned, so they are kept from state.
+func flattenDataSourceCredentials(secretARN *string, tfList []interface{}) []interface{} {
+	var tfMap map[string]interface{}
+	if len(tfList) > 0 && tfList[0] != nil {
+		tfMap = tfList[0].(map[string]interface{})
+	}
+
+	// Credentials copied from another data source may report that data source's secret.
+	if v, ok := tfMap["copy_source_arn"].(string); ok && v != "" {
+		return tfList
+	}
+
+	if secretARN != nil {
+		return []interface{}{map[string]interface{}{
+			"secret_arn": aws.ToString(secretARN),
+		}}
+	}
+
+	// The secret was removed outside of Terraform.
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		return nil
+	}
+
+	return tfList
+}
+
 func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
@@ -197,7 +279,7 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept("permission", names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("folder_arns", "permission", names.AttrTags, names.AttrTagsAll) {
 		input := &quicksight.UpdateDataSourceInput{
 			AwsAccountId: aws.String(awsAccountID),
 			DataSourceId: aws.String(dataSourceID),
@@ -231,6 +313,14 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("folder_arns") {
+		o, n := d.GetChange("folder_arns")
+
+		if err := updateFolderMemberships(ctx, conn, awstypes.MemberTypeDatasource, dataSourceID, flex.ExpandStringValueSet(o.(*schema.Set)), flex.ExpandStringValueSet(n.(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Data Source (%s) folders: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("permission") {
 		o, n := d.GetChange("permission")
 		os, ns := o.(*schema.Set), n.(*schema.Set)
@@ -428,6 +518,25 @@ func waitDataSourceUpdated(ctx context.Context, conn *quicksight.Client, awsAcco
 	return nil, err
 }
 
+// dataSourceFailedStatusError returns the error information of a data source whose creation or last update failed.
+func dataSourceFailedStatusError(apiObject *awstypes.DataSource) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch apiObject.Status {
+	case awstypes.ResourceStatusCreationFailed, awstypes.ResourceStatusUpdateFailed:
+	default:
+		return nil
+	}
+
+	if err := dataSourceError(apiObject.ErrorInfo); err != nil {
+		return err
+	}
+
+	return errors.New("no error information returned")
+}
+
 func dataSourceError(apiObject *awstypes.DataSourceErrorInfo) error {
 	if apiObject == nil {
 		return nil
@@ -7,12 +7,16 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,6 +25,127 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestFlattenDataSourceCredentials(t *testing.T) {
+	t.Parallel()
+
+	const (
+		secretARN     = "arn:aws:secretsmanager:us-west-2:123456789012:secret:example" //lintignore:AWSAT003,AWSAT005
+		newSecretARN  = "arn:aws:secretsmanager:us-west-2:123456789012:secret:rotated" //lintignore:AWSAT003,AWSAT005
+		copySourceARN = "arn:aws:quicksight:us-west-2:123456789012:datasource/example" //lintignore:AWSAT003,AWSAT005
+	)
+	credentialPair := []interface{}{map[string]interface{}{
+		"credential_pair": []interface{}{map[string]interface{}{
+			names.AttrPassword: "password",
+			names.AttrUsername: "username",
+		}},
+		"copy_source_arn": "",
+		"secret_arn":      "",
+	}}
+
+	testCases := map[string]struct {
+		secretARN *string
+		state     []interface{}
+		expected  []interface{}
+	}{
+		"no credentials": {},
+		"imported secret": {
+			secretARN: aws.String(secretARN),
+			expected:  []interface{}{map[string]interface{}{"secret_arn": secretARN}},
+		},
+		"secret changed": {
+			secretARN: aws.String(newSecretARN),
+			state:     []interface{}{map[string]interface{}{"secret_arn": secretARN}},
+			expected:  []interface{}{map[string]interface{}{"secret_arn": newSecretARN}},
+		},
+		"secret removed": {
+			state: []interface{}{map[string]interface{}{"secret_arn": secretARN}},
+		},
+		"credential pair": {
+			state:    credentialPair,
+			expected: credentialPair,
+		},
+		"credential pair replaced by secret": {
+			secretARN: aws.String(secretARN),
+			state:     credentialPair,
+			expected:  []interface{}{map[string]interface{}{"secret_arn": secretARN}},
+		},
+		"copy source": {
+			secretARN: aws.String(secretARN),
+			state:     []interface{}{map[string]interface{}{"copy_source_arn": copySourceARN}},
+			expected:  []interface{}{map[string]interface{}{"copy_source_arn": copySourceARN}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfquicksight.FlattenDataSourceCredentials(testCase.secretARN, testCase.state)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataSourceFailedStatusError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dataSource *awstypes.DataSource
+		expected   string
+	}{
+		"nil": {},
+		"successful": {
+			dataSource: &awstypes.DataSource{
+				Status: awstypes.ResourceStatusCreationSuccessful,
+			},
+		},
+		"creation failed": {
+			dataSource: &awstypes.DataSource{
+				ErrorInfo: &awstypes.DataSourceErrorInfo{
+					Message: aws.String("Unable to connect to the host"),
+					Type:    awstypes.DataSourceErrorInfoTypeTimeout,
+				},
+				Status: awstypes.ResourceStatusCreationFailed,
+			},
+			expected: "TIMEOUT: Unable to connect to the host",
+		},
+		"update failed": {
+			dataSource: &awstypes.DataSource{
+				ErrorInfo: &awstypes.DataSourceErrorInfo{
+					Message: aws.String("Access denied"),
+					Type:    awstypes.DataSourceErrorInfoTypeAccessDenied,
+				},
+				Status: awstypes.ResourceStatusUpdateFailed,
+			},
+			expected: "ACCESS_DENIED: Access denied",
+		},
+		"update failed without error info": {
+			dataSource: &awstypes.DataSource{
+				Status: awstypes.ResourceStatusUpdateFailed,
+			},
+			expected: "no error information returned",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			if err := tfquicksight.DataSourceFailedStatusError(testCase.dataSource); err != nil {
+				got = err.Error()
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("DataSourceFailedStatusError = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccQuickSightDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dataSource awstypes.DataSource
@@ -214,6 +339,7 @@ func TestAccQuickSightDataSource_secretARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dataSource awstypes.DataSource
 	resourceName := "aws_quicksight_data_source.test"
+	secretResourceName := "aws_secretsmanager_secret.qs-secret-test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -230,7 +356,404 @@ func TestAccQuickSightDataSource_secretARN(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "data_source_id", rId),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, "AURORA_POSTGRESQL"),
 					resource.TestCheckResourceAttr(resourceName, "credentials.#", acctest.Ct1),
-					resource.TestCheckResourceAttrSet(resourceName, "credentials.0.secret_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "credentials.0.secret_arn", secretResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_credentialPairToSecretARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	secretResourceName := "aws_secretsmanager_secret.qs-secret-test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_credentialPair(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "credentials.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.credential_pair.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.secret_arn", ""),
+				),
+			},
+			{
+				Config: testAccDataSourceConfig_secret_arn(rId, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "credentials.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.credential_pair.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "credentials.0.secret_arn", secretResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_copySourceARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.copy"
+	sourceResourceName := "aws_quicksight_data_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	copyId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_copySourceARN(rId, rName, copyId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "data_source_id", copyId),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "AURORA_POSTGRESQL"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "credentials.0.copy_source_arn", sourceResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_copySourceARNInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceConfig_copySourceARNInvalid(rId, rName, acctest.Partition(), acctest.Region()),
+				ExpectError: regexache.MustCompile(`is not a QuickSight data source ARN`),
+			},
+		},
+	})
+}
+
+// Snowflake and Databricks data sources are validated against a live connection, so these tests require existing
+// warehouses. The *_SECRET_ARN variables name Secrets Manager secrets holding the username and password.
+const (
+	envVarSnowflakeDatabase         = "QUICKSIGHT_SNOWFLAKE_DATABASE"
+	envVarSnowflakeHost             = "QUICKSIGHT_SNOWFLAKE_HOST"
+	envVarSnowflakeSecretARN        = "QUICKSIGHT_SNOWFLAKE_SECRET_ARN"
+	envVarSnowflakeWarehouse        = "QUICKSIGHT_SNOWFLAKE_WAREHOUSE"
+	envVarDatabricksHost            = "QUICKSIGHT_DATABRICKS_HOST"
+	envVarDatabricksSecretARN       = "QUICKSIGHT_DATABRICKS_SECRET_ARN"
+	envVarDatabricksSQLEndpointPath = "QUICKSIGHT_DATABRICKS_SQL_ENDPOINT_PATH"
+)
+
+// The Redshift IAM authentication test requires a provisioned cluster reachable from QuickSight and an IAM role
+// that QuickSight can assume to call redshift:GetClusterCredentialsWithIAM for it.
+const (
+	envVarRedshiftClusterID = "QUICKSIGHT_REDSHIFT_CLUSTER_ID"
+	envVarRedshiftRoleARN   = "QUICKSIGHT_REDSHIFT_ROLE_ARN"
+)
+
+func TestAccQuickSightDataSource_snowflake(t *testing.T) {
+	ctx := acctest.Context(t)
+	database := acctest.SkipIfEnvVarNotSet(t, envVarSnowflakeDatabase)
+	host := acctest.SkipIfEnvVarNotSet(t, envVarSnowflakeHost)
+	secretARN := acctest.SkipIfEnvVarNotSet(t, envVarSnowflakeSecretARN)
+	warehouse := acctest.SkipIfEnvVarNotSet(t, envVarSnowflakeWarehouse)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_snowflake(rId, rName, host, database, warehouse, secretARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "SNOWFLAKE"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.0.database", database),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.0.host", host),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.0.warehouse", warehouse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_databricks(t *testing.T) {
+	ctx := acctest.Context(t)
+	host := acctest.SkipIfEnvVarNotSet(t, envVarDatabricksHost)
+	secretARN := acctest.SkipIfEnvVarNotSet(t, envVarDatabricksSecretARN)
+	sqlEndpointPath := acctest.SkipIfEnvVarNotSet(t, envVarDatabricksSQLEndpointPath)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_databricks(rId, rName, host, sqlEndpointPath, secretARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "DATABRICKS"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.0.host", host),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.0.port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.0.sql_endpoint_path", sqlEndpointPath),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_parametersExactlyOneOf(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceConfig_parametersMultiple(rId, rName),
+				ExpectError: regexache.MustCompile(`only one of .* can be specified`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_redshiftIAMParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	clusterID := acctest.SkipIfEnvVarNotSet(t, envVarRedshiftClusterID)
+	roleARN := acctest.SkipIfEnvVarNotSet(t, envVarRedshiftRoleARN)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_redshiftIAMParameters(rId, rName, fmt.Sprintf("cluster_id = %q", clusterID), roleARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "REDSHIFT"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.0.cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.0.iam_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.0.iam_parameters.0.auto_create_database_user", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.0.iam_parameters.0.role_arn", roleARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_redshiftDefaultedEndpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	clusterID := acctest.SkipIfEnvVarNotSet(t, envVarRedshiftClusterID)
+	roleARN := acctest.SkipIfEnvVarNotSet(t, envVarRedshiftRoleARN)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// QuickSight fills in the host and port of the cluster.
+				Config: testAccDataSourceConfig_redshiftIAMParameters(rId, rName, fmt.Sprintf("cluster_id = %q", clusterID), roleARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.redshift.0.host"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.redshift.0.port"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_redshiftEndpointValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleARN := fmt.Sprintf("arn:%s:iam::123456789012:role/example", acctest.Partition()) //lintignore:AWSAT005
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceConfig_redshiftIAMParameters(rId, rName, "cluster_id = \"example\"\n      host       = \"example.com\"\n      port       = 5439", roleARN),
+				ExpectError: regexache.MustCompile(`redshift.0: only one of cluster_id or host can be specified`),
+			},
+			{
+				Config:      testAccDataSourceConfig_redshiftIAMParameters(rId, rName, "host = \"example.com\"", roleARN),
+				ExpectError: regexache.MustCompile(`redshift.0: host and port must be specified together`),
+			},
+			{
+				Config:      testAccDataSourceConfig_redshiftIAMParameters(rId, rName, "", roleARN),
+				ExpectError: regexache.MustCompile(`redshift.0: one of cluster_id or host must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_alternateDataSourceParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceConfig_alternateDataSourceParameters(rId, rName, "athena {\n          work_group = \"primary\"\n        }"),
+				ExpectError: regexache.MustCompile(`alternate_data_source_parameters.0: athena parameters don't match the redshift data source parameters`),
+			},
+			{
+				Config:             testAccDataSourceConfig_alternateDataSourceParameters(rId, rName, "redshift {\n          cluster_id = \"example-replica\"\n          database   = \"dev\"\n        }"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The alternate endpoint is validated on its own, not against the primary's cluster_id.
+				Config:             testAccDataSourceConfig_alternateDataSourceParameters(rId, rName, "redshift {\n          database = \"dev\"\n          host     = \"example.com\"\n          port     = 5439\n        }"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccDataSourceConfig_alternateDataSourceParameters(rId, rName, "redshift {\n          database = \"dev\"\n          host     = \"example.com\"\n        }"),
+				ExpectError: regexache.MustCompile(`alternate_data_source_parameters.0.redshift.0: host and port must be specified together`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSource_folderARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource awstypes.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	folder1ResourceName := "aws_quicksight_folder.test1"
+	folder2ResourceName := "aws_quicksight_folder.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_folderARNs(rId, rName, folder1ResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "folder_arns.*", folder1ResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSourceConfig_folderARNs(rId, rName, folder2ResourceName+".arn"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "folder_arns.*", folder2ResourceName, names.AttrARN),
+				),
+			},
+			{
+				// Removing the last folder keeps the data source.
+				Config: testAccDataSourceConfig_folderARNs(rId, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "folder_arns.#", acctest.Ct0),
 				),
 			},
 		},
@@ -386,6 +909,39 @@ resource "aws_quicksight_data_source" "test" {
 `, rId, rName))
 }
 
+func testAccDataSourceConfig_folderARNs(rId, rName string, folderARNs ...string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_folder" "test1" {
+  folder_id = "%[1]s-1"
+  name      = "%[2]s-1"
+}
+
+resource "aws_quicksight_folder" "test2" {
+  folder_id = "%[1]s-2"
+  name      = "%[2]s-2"
+}
+
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+  folder_arns    = [%[3]s]
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_object.test.key
+      }
+    }
+  }
+
+  type = "S3"
+}
+`, rId, rName, strings.Join(folderARNs, ", ")))
+}
+
 func testAccDataSource_UserConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -517,6 +1073,21 @@ resource "aws_quicksight_data_source" "test" {
 }
 
 func testAccDataSourceConfig_secret_arn(rId, rName string) string {
+	return testAccDataSourceConfig_rdsCredentials(rId, rName, `
+    secret_arn = aws_secretsmanager_secret.qs-secret-test.arn
+`)
+}
+
+func testAccDataSourceConfig_credentialPair(rId, rName string) string {
+	return testAccDataSourceConfig_rdsCredentials(rId, rName, `
+    credential_pair {
+      username = "foo"
+      password = "must_be_eight_characters"
+    }
+`)
+}
+
+func testAccDataSourceConfig_rdsCredentials(rId, rName, credentials string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
   name = "qs-vpc-connnection-tf-test"
@@ -739,13 +1310,35 @@ resource "aws_secretsmanager_secret_version" "example" {
 }
 
 resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+  vpc_connection_properties {
+    vpc_connection_arn = aws_quicksight_vpc_connection.qs-rds-vpc-conn-test.arn
+  }
+  credentials {%[3]s  }
+  parameters {
+    rds {
+      database    = aws_rds_cluster.qs-rds-tf-test-cluster.database_name
+      instance_id = aws_rds_cluster_instance.qs-rds-tf-test-cluster-instance.identifier
+    }
+  }
+  type = "AURORA_POSTGRESQL"
+}
+`, rId, rName, credentials)
+}
+
+func testAccDataSourceConfig_copySourceARN(rId, rName, copyId string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig_secret_arn(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "copy" {
   data_source_id = %[1]q
   name           = %[2]q
   vpc_connection_properties {
     vpc_connection_arn = aws_quicksight_vpc_connection.qs-rds-vpc-conn-test.arn
   }
   credentials {
-    secret_arn = aws_secretsmanager_secret.qs-secret-test.arn
+    copy_source_arn = aws_quicksight_data_source.test.arn
   }
   parameters {
     rds {
@@ -755,5 +1348,145 @@ resource "aws_quicksight_data_source" "test" {
   }
   type = "AURORA_POSTGRESQL"
 }
+`, copyId, rName))
+}
+
+func testAccDataSourceConfig_copySourceARNInvalid(rId, rName, partition, region string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+  credentials {
+    copy_source_arn = "arn:%[3]s:quicksight:%[4]s:123456789012:dataset/example"
+  }
+  parameters {
+    rds {
+      database    = "example"
+      instance_id = "example"
+    }
+  }
+  type = "AURORA_POSTGRESQL"
+}
+`, rId, rName, partition, region)
+}
+
+func testAccDataSourceConfig_snowflake(rId, rName, host, database, warehouse, secretARN string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  credentials {
+    secret_arn = %[6]q
+  }
+
+  parameters {
+    snowflake {
+      database  = %[4]q
+      host      = %[3]q
+      warehouse = %[5]q
+    }
+  }
+
+  type = "SNOWFLAKE"
+}
+`, rId, rName, host, database, warehouse, secretARN)
+}
+
+func testAccDataSourceConfig_databricks(rId, rName, host, sqlEndpointPath, secretARN string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  credentials {
+    secret_arn = %[5]q
+  }
+
+  parameters {
+    databricks {
+      host              = %[3]q
+      port              = 443
+      sql_endpoint_path = %[4]q
+    }
+  }
+
+  type = "DATABRICKS"
+}
+`, rId, rName, host, sqlEndpointPath, secretARN)
+}
+
+func testAccDataSourceConfig_parametersMultiple(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    big_query {
+      project_id = "example"
+    }
+
+    starburst {
+      catalog = "example"
+      host    = "example.com"
+      port    = 443
+    }
+  }
+
+  type = "BIGQUERY"
+}
 `, rId, rName)
 }
+
+func testAccDataSourceConfig_redshiftIAMParameters(rId, rName, endpoint, roleARN string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    redshift {
+      %[3]s
+      database = "dev"
+
+      iam_parameters {
+        auto_create_database_user = true
+        role_arn                  = %[4]q
+      }
+    }
+  }
+
+  type = "REDSHIFT"
+}
+`, rId, rName, endpoint, roleARN)
+}
+
+func testAccDataSourceConfig_alternateDataSourceParameters(rId, rName, alternate string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  credentials {
+    credential_pair {
+      password = "password"
+      username = "username"
+
+      alternate_data_source_parameters {
+        %[3]s
+      }
+    }
+  }
+
+  parameters {
+    redshift {
+      cluster_id = "example"
+      database   = "dev"
+    }
+  }
+
+  type = "REDSHIFT"
+}
+`, rId, rName, alternate)
+}
@@ -3,6 +3,10 @@
 
 package quicksight
 
+import (
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceAccountSubscription = resourceAccountSubscription
@@ -18,34 +22,86 @@ var (
 	ResourceIngestion           = newIngestionResource
 	ResourceNamespace           = newNamespaceResource
 	ResourceRefreshSchedule     = newRefreshScheduleResource
+	ResourceRoleMembership      = newRoleMembershipResource
 	ResourceTemplate            = resourceTemplate
 	ResourceTemplateAlias       = newTemplateAliasResource
 	ResourceTheme               = resourceTheme
 	ResourceUser                = resourceUser
 	ResourceVPCConnection       = newVPCConnectionResource
 
-	DashboardLatestVersion                = dashboardLatestVersion
-	DefaultGroupNamespace                 = defaultGroupNamespace
-	DefaultIAMPolicyAssignmentNamespace   = defaultIAMPolicyAssignmentNamespace
-	DefaultUserNamespace                  = defaultUserNamespace
-	FindAccountSubscriptionByID           = findAccountSubscriptionByID
-	FindAnalysisByTwoPartKey              = findAnalysisByTwoPartKey
-	FindDashboardByThreePartKey           = findDashboardByThreePartKey
-	FindDataSetByTwoPartKey               = findDataSetByTwoPartKey
-	FindDataSourceByTwoPartKey            = findDataSourceByTwoPartKey
-	FindFolderByTwoPartKey                = findFolderByTwoPartKey
-	FindFolderMembershipByFourPartKey     = findFolderMembershipByFourPartKey
-	FindGroupByThreePartKey               = findGroupByThreePartKey
-	FindGroupMembershipByFourPartKey      = findGroupMembershipByFourPartKey
-	FindIAMPolicyAssignmentByThreePartKey = findIAMPolicyAssignmentByThreePartKey
-	FindIngestionByThreePartKey           = findIngestionByThreePartKey
-	FindNamespaceByTwoPartKey             = findNamespaceByTwoPartKey
-	FindRefreshScheduleByThreePartKey     = findRefreshScheduleByThreePartKey
-	FindTemplateAliasByThreePartKey       = findTemplateAliasByThreePartKey
-	FindTemplateByTwoPartKey              = findTemplateByTwoPartKey
-	FindThemeByTwoPartKey                 = findThemeByTwoPartKey
-	FindUserByThreePartKey                = findUserByThreePartKey
-	FindVPCConnectionByTwoPartKey         = findVPCConnectionByTwoPartKey
+	AccountDirectoryType                        = accountDirectoryType
+	AccountSubscriptionSignUpOnlyDiffSuppress   = accountSubscriptionSignUpOnlyDiffSuppress
+	AddNamespaceCapacityRegionWarning           = addNamespaceCapacityRegionWarning
+	AppendDefinitionSizeWarning                 = appendDefinitionSizeWarning
+	AuthenticationMethodSupportsRoleMemberships = authenticationMethodSupportsRoleMemberships
+	CheckAccountSubscriptionDirectory           = checkAccountSubscriptionDirectory
+	CreateAccountSubscription                   = createAccountSubscription
+	DashboardLatestVersion                      = dashboardLatestVersion
+	DashboardSourceModeChanged                  = dashboardSourceModeChanged
+	DashboardVersionErrors                      = dashboardVersionErrors
+	DataSourceFailedStatusError                 = dataSourceFailedStatusError
+	DefaultGroupNamespace                       = defaultGroupNamespace
+	DefaultIAMPolicyAssignmentNamespace         = defaultIAMPolicyAssignmentNamespace
+	DefaultUserNamespace                        = defaultUserNamespace
+	DefinitionSizeNearLimit                     = definitionSizeNearLimit
+	DeleteDashboardVersion                      = deleteDashboardVersion
+	DiffTemplateAliases                         = diffTemplateAliases
+	EditionSupportsCapacityPricing              = editionSupportsCapacityPricing
+	FindAccountSubscriptionByID                 = findAccountSubscriptionByID
+	FindAnalysisByTwoPartKey                    = findAnalysisByTwoPartKey
+	FindDashboardByThreePartKey                 = findDashboardByThreePartKey
+	FindDashboardVersionNumbers                 = findDashboardVersionNumbers
+	FindDataSetByTwoPartKey                     = findDataSetByTwoPartKey
+	FindDataSetRefreshPropertiesByTwoPartKey    = findDataSetRefreshPropertiesByTwoPartKey
+	FindDataSourceByTwoPartKey                  = findDataSourceByTwoPartKey
+	FindFolderByTwoPartKey                      = findFolderByTwoPartKey
+	FindFolderMembershipByFourPartKey           = findFolderMembershipByFourPartKey
+	FindGroupByThreePartKey                     = findGroupByThreePartKey
+	FindGroupMembershipByFourPartKey            = findGroupMembershipByFourPartKey
+	FindIAMPolicyAssignmentByThreePartKey       = findIAMPolicyAssignmentByThreePartKey
+	FindIngestionByThreePartKey                 = findIngestionByThreePartKey
+	FindLatestIngestionByTwoPartKey             = findLatestIngestionByTwoPartKey
+	FindNamespaceByTwoPartKey                   = findNamespaceByTwoPartKey
+	FindRefreshScheduleByThreePartKey           = findRefreshScheduleByThreePartKey
+	FindRoleMembershipByFourPartKey             = findRoleMembershipByFourPartKey
+	FindTemplateAliasByThreePartKey             = findTemplateAliasByThreePartKey
+	FindTemplateByTwoPartKey                    = findTemplateByTwoPartKey
+	FindTemplateVersionNumbers                  = findTemplateVersionNumbers
+	FindThemeByTwoPartKey                       = findThemeByTwoPartKey
+	FindUserByThreePartKey                      = findUserByThreePartKey
+	FindVPCConnectionByTwoPartKey               = findVPCConnectionByTwoPartKey
+	FlattenDataSourceCredentials                = flattenDataSourceCredentials
+	FolderARN                                   = folderARN
+	IsQuickSightIdentityCenterApplication       = isQuickSightIdentityCenterApplication
+	IsTerminationProtectionError                = isTerminationProtectionError
+	NamespaceARN                                = namespaceARN
+	NamespaceNotFoundError                      = namespaceNotFoundError
+	NamespacedResourceNotFoundMessage           = namespacedResourceNotFoundMessage
+	ParseAnalysisARN                            = parseAnalysisARN
+	ParseDataSetARN                             = parseDataSetARN
+	ParseFolderARN                              = parseFolderARN
+	ResourceARN                                 = resourceARN
+	TemplateSourceAnalysisMissingDataSets       = templateSourceAnalysisMissingDataSets
+	ThemeVersionErrors                          = themeVersionErrors
+	UpdateAccountSettings                       = updateAccountSettings
+	ValidFolderARN                              = validFolderARN
+	ValidateAccountSubscriptionActiveDirectory  = validateAccountSubscriptionActiveDirectory
+
+	EnvVarAccountSubscriptionPollDelay = envVarAccountSubscriptionPollDelay
+	ListTags                           = listTags
+	UpdateTags                         = updateTags
+	ListVPCConnectionsPagesWithBackoff = listPagesWithBackoff[*quicksight.ListVPCConnectionsOutput]
+	SetAccountSubscriptionPollDelay    = setAccountSubscriptionPollDelay
+	StartAfterDateTimeLayout           = startAfterDateTimeLayout
+	VersionsToPrune                    = versionsToPrune
+	WaitAccountSubscriptionCreated     = waitAccountSubscriptionCreated
+	WaitIngestion                      = waitIngestion
+	WaitThemeUpdated                   = waitThemeUpdated
+	WaitThemeVersionCreated            = waitThemeVersionCreated
 
-	StartAfterDateTimeLayout = startAfterDateTimeLayout
+	AccountSubscriptionSignupInProgress                 = accountSubscriptionSignupInProgress
+	AccountSubscriptionStatusIdentityCenterProvisioning = accountSubscriptionStatusIdentityCenterProvisioning
+	AccountSubscriptionStatusPending                    = accountSubscriptionStatusPending
+	StatusAccountSubscriptionIdentityCenter             = statusAccountSubscriptionIdentityCenter
+	StatusNamespaceCreate                               = statusNamespaceCreate
 )
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -67,13 +69,10 @@ func resourceFolder() *schema.Resource {
 				Computed: true,
 			},
 			"folder_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.All(
-					validation.NoZeroValues,
-					validation.StringLenBetween(1, 2048),
-				),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: quicksightschema.ValidResourceID(quicksightschema.FolderIDMaxLen),
 			},
 			"folder_path": {
 				Type:     schema.TypeList,
@@ -111,10 +110,61 @@ func resourceFolder() *schema.Resource {
 			names.AttrTagsAll:     tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			folderParentCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// folderParentCustomizeDiff returns an error if parent_folder_arn references the folder itself or one of
+// its descendants. A folder's path lists all of its ancestors, so describing the parent is enough to detect
+// a cycle of any depth.
+func folderParentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("parent_folder_arn") || !d.NewValueKnown("parent_folder_arn") {
+		return nil
+	}
+
+	parentARN := d.Get("parent_folder_arn").(string)
+	if parentARN == "" {
+		return nil
+	}
+
+	awsClient := meta.(*conns.AWSClient)
+	awsAccountID := awsClient.AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+	arn := folderARN(awsClient.Partition, awsClient.Region, awsAccountID, d.Get("folder_id").(string))
+
+	if parentARN == arn {
+		return fmt.Errorf("parent_folder_arn (%s) references the folder itself", parentARN)
+	}
+
+	parentAccountID, parentFolderID, err := parseFolderARN(parentARN)
+	if err != nil {
+		// Leave malformed parent ARNs to the API.
+		return nil //nolint:nilerr // Not a folder ARN.
+	}
+
+	parent, err := findFolderByTwoPartKey(ctx, awsClient.QuickSightClient(ctx), parentAccountID, parentFolderID)
+
+	// The parent may be created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading QuickSight Folder (%s): %w", parentARN, err)
+	}
+
+	if slices.Contains(parent.FolderPath, arn) {
+		return fmt.Errorf("parent_folder_arn (%s) is a descendant of QuickSight Folder (%s)", parentARN, arn)
+	}
+
+	return nil
+}
+
 func resourceFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
@@ -297,6 +347,44 @@ func folderParseResourceID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// folderARNsSchema returns the schema of the folder_arns argument of assets that can be placed in folders when they are created.
+// The set is Computed so that memberships managed elsewhere don't show up as drift when the argument isn't set.
+func folderARNsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validFolderARN,
+		},
+	}
+}
+
+// folderARNsCustomizeDiff removes an asset from all of its folders when folder_arns is explicitly set to an empty set,
+// which a Computed argument would otherwise treat as unset.
+func folderARNsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("folder_arns"); !v.IsKnown() || v.IsNull() || v.LengthInt() > 0 {
+		return nil
+	}
+
+	if d.Get("folder_arns").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	return d.SetNew("folder_arns", []string{})
+}
+
+// folderARNsInUse returns whether folder_arns is configured or already in state. Folders are only listed for these
+// assets, so that reading an asset doesn't depend on folder support or on permission to list folders.
+func folderARNsInUse(d *schema.ResourceData) bool {
+	return d.Get("folder_arns").(*schema.Set).Len() > 0
+}
+
 func findFolderByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, folderID string) (*awstypes.Folder, error) {
 	input := &quicksight.DescribeFolderInput{
 		AwsAccountId: aws.String(awsAccountID),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -252,6 +253,93 @@ func findFolderMemberships(ctx context.Context, conn *quicksight.Client, input *
 	return output, nil
 }
 
+// findFolderARNsForResource returns the ARNs of the folders that contain the specified asset.
+func findFolderARNsForResource(ctx context.Context, conn *quicksight.Client, awsAccountID, resourceARN string) ([]string, error) {
+	input := &quicksight.ListFoldersForResourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ResourceArn:  aws.String(resourceARN),
+	}
+	var output []string
+
+	err := listPages(ctx, quicksight.NewListFoldersForResourcePaginator(conn, input), func(page *quicksight.ListFoldersForResourceOutput) bool {
+		output = append(output, page.Folders...)
+
+		return true
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// isFolderListingUnavailableError returns whether err reports that folders can't be listed, because the caller isn't
+// allowed to or because the account's edition doesn't support folders.
+func isFolderListingUnavailableError(err error) bool {
+	return errs.IsA[*awstypes.AccessDeniedException](err) || errs.IsA[*awstypes.UnsupportedUserEditionException](err)
+}
+
+// updateFolderMemberships moves an asset from the old to the new set of folders, identified by ARN.
+func updateFolderMemberships(ctx context.Context, conn *quicksight.Client, memberType awstypes.MemberType, memberID string, o, n []string) error {
+	for _, folderARN := range n {
+		if slices.Contains(o, folderARN) {
+			continue
+		}
+
+		awsAccountID, folderID, err := parseFolderARN(folderARN)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.CreateFolderMembership(ctx, &quicksight.CreateFolderMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+			MemberId:     aws.String(memberID),
+			MemberType:   memberType,
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding to folder (%s): %w", folderARN, err)
+		}
+	}
+
+	for _, folderARN := range o {
+		if slices.Contains(n, folderARN) {
+			continue
+		}
+
+		awsAccountID, folderID, err := parseFolderARN(folderARN)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeleteFolderMembership(ctx, &quicksight.DeleteFolderMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+			MemberId:     aws.String(memberID),
+			MemberType:   memberType,
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing from folder (%s): %w", folderARN, err)
+		}
+	}
+
+	return nil
+}
+
 const folderMembershipResourceIDSeparator = ","
 
 func folderMembershipCreateResourceID(awsAccountID, folderID, memberType, memberID string) string {
@@ -56,6 +56,34 @@ func TestAccQuickSightFolder_basic(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightFolder_tagsOnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var folder awstypes.Folder
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_folder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig_tags1(rId, rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(ctx, resourceName, &folder),
+					// Tags are passed to CreateFolder rather than added afterwards with TagResource.
+					testAccCheckTagsInAWS(ctx, resourceName, map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightFolder_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var folder awstypes.Folder
@@ -190,6 +218,42 @@ func TestAccQuickSightFolder_parentFolder(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightFolder_parentFolderCycle(t *testing.T) {
+	ctx := acctest.Context(t)
+	var folder awstypes.Folder
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	parentId := rId + "-parent"
+	parentName := rName + "-parent"
+	resourceName := "aws_quicksight_folder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.QuickSightEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFolderConfig_parentFolderSelf(rId, rName),
+				ExpectError: regexache.MustCompile(`references the folder itself`),
+			},
+			{
+				Config: testAccFolderConfig_parentFolder(rId, rName, parentId, parentName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(ctx, resourceName, &folder),
+				),
+			},
+			{
+				Config:      testAccFolderConfig_parentFolderCycle(rId, rName, parentId, parentName),
+				ExpectError: regexache.MustCompile(`is a descendant of QuickSight Folder`),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightFolder_parentFolderNested(t *testing.T) {
 	ctx := acctest.Context(t)
 	var folder awstypes.Folder
@@ -289,6 +353,19 @@ resource "aws_quicksight_folder" "test" {
 `, rId, rName)
 }
 
+func testAccFolderConfig_tags1(rId, rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rId, rName, tagKey1, tagValue1)
+}
+
 func testAccFolderConfigUserBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -383,3 +460,41 @@ resource "aws_quicksight_folder" "test" {
 }
 `, rId, rName, parentId1, parentName1, parentId2, parentName2)
 }
+
+func testAccFolderConfig_parentFolderSelf(rId, rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_quicksight_folder" "test" {
+  folder_id         = %[1]q
+  name              = %[2]q
+  parent_folder_arn = "arn:${data.aws_partition.current.partition}:quicksight:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:folder/%[1]s"
+}
+`, rId, rName)
+}
+
+func testAccFolderConfig_parentFolderCycle(rId, rName, parentId, parentName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_quicksight_folder" "parent" {
+  folder_id         = %[3]q
+  name              = %[4]q
+  parent_folder_arn = "arn:${data.aws_partition.current.partition}:quicksight:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:folder/%[1]s"
+}
+
+resource "aws_quicksight_folder" "test" {
+  folder_id         = %[1]q
+  name              = %[2]q
+  parent_folder_arn = aws_quicksight_folder.parent.arn
+}
+`, rId, rName, parentId, parentName)
+}
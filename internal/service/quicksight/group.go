@@ -71,6 +71,10 @@ func resourceGroup() *schema.Resource {
 						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]*$`), "must contain only alphanumeric characters, hyphens, underscores, and periods"),
 					),
 				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 			}
 		},
 	}
@@ -100,7 +104,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	_, err := conn.CreateGroup(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating QuickSight Group (%s): %s", id, err)
+		return sdkdiag.AppendErrorf(diags, "creating QuickSight Group (%s): %s", id, namespaceNotFoundError(err, namespace))
 	}
 
 	d.SetId(id)
@@ -134,6 +138,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set(names.AttrDescription, group.Description)
 	d.Set(names.AttrGroupName, group.GroupName)
 	d.Set(names.AttrNamespace, namespace)
+	d.Set("principal_id", group.PrincipalId)
 
 	return diags
 }
@@ -229,6 +234,7 @@ func findGroup(ctx context.Context, conn *quicksight.Client, input *quicksight.D
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
+			Message:     namespacedResourceNotFoundMessage(err, aws.ToString(input.Namespace), "Group", aws.ToString(input.GroupName)),
 		}
 	}
 
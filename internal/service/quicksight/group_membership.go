@@ -95,7 +95,7 @@ func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData,
 	_, err := conn.CreateGroupMembership(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating QuickSight Group Membership (%s): %s", id, err)
+		return sdkdiag.AppendErrorf(diags, "creating QuickSight Group Membership (%s): %s", id, namespaceNotFoundError(err, namespace))
 	}
 
 	d.SetId(id)
@@ -211,6 +211,7 @@ func findGroupMemberships(ctx context.Context, conn *quicksight.Client, input *q
 			return nil, &retry.NotFoundError{
 				LastError:   err,
 				LastRequest: input,
+				Message:     namespacedResourceNotFoundMessage(err, aws.ToString(input.Namespace), "Group", aws.ToString(input.GroupName)),
 			}
 		}
 
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -57,6 +59,47 @@ func TestAccQuickSightGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightGroup_principalID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.Group
+	resourceName := "aws_quicksight_group.default"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_id"),
+					resource.TestCheckResourceAttrWith(resourceName, "principal_id", func(value string) error {
+						if want := aws.ToString(group.PrincipalId); value != want {
+							return fmt.Errorf("principal_id = %q, want %q", value, want)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttrWith(resourceName, "principal_id", func(value string) error {
+						if want := aws.ToString(group.PrincipalId); value == "" || value != want {
+							return fmt.Errorf("principal_id = %q, want %q", value, want)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightGroup_withDescription(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group awstypes.Group
@@ -116,6 +159,24 @@ func TestAccQuickSightGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightGroup_namespaceNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupConfig_namespace(rName, rName),
+				ExpectError: regexache.MustCompile(fmt.Sprintf(`QuickSight Namespace \(%s\) not found`, rName)),
+			},
+		},
+	})
+}
+
 func testAccCheckGroupExists(ctx context.Context, n string, v *awstypes.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -182,3 +243,12 @@ resource "aws_quicksight_group" "default" {
 }
 `, rName, description)
 }
+
+func testAccGroupConfig_namespace(rName, namespace string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "default" {
+  group_name = %[1]q
+  namespace  = %[2]q
+}
+`, rName, namespace)
+}
@@ -8,12 +8,16 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -30,7 +34,11 @@ import (
 
 // @FrameworkResource("aws_quicksight_ingestion", name="Ingestion")
 func newIngestionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &ingestionResource{}, nil
+	r := &ingestionResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -39,7 +47,8 @@ const (
 
 type ingestionResource struct {
 	framework.ResourceWithConfigure
-	framework.WithNoUpdate
+	framework.WithTimeouts
+	framework.WithNoOpUpdate[resourceIngestionData]
 	framework.WithImportByID
 }
 
@@ -52,6 +61,9 @@ func (r *ingestionResource) Schema(ctx context.Context, req resource.SchemaReque
 		Attributes: map[string]schema.Attribute{
 			names.AttrARN: schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			names.AttrAWSAccountID: schema.StringAttribute{
 				Optional: true,
@@ -76,6 +88,9 @@ func (r *ingestionResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 			"ingestion_status": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"ingestion_type": schema.StringAttribute{
 				Required: true,
@@ -86,6 +101,28 @@ func (r *ingestionResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"rows_dropped": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rows_ingested": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -129,6 +166,27 @@ func (r *ingestionResource) Create(ctx context.Context, req resource.CreateReque
 	plan.ID = flex.StringValueToFramework(ctx, ingestionCreateResourceID(awsAccountID, dataSetID, ingestionID))
 	plan.ARN = flex.StringToFramework(ctx, out.Arn)
 	plan.IngestionStatus = flex.StringValueToFramework(ctx, out.IngestionStatus)
+	plan.RowsDropped = types.Int64Null()
+	plan.RowsIngested = types.Int64Null()
+
+	if plan.WaitForCompletion.ValueBool() {
+		ingestion, err := waitIngestionCompleted(ctx, conn, awsAccountID, dataSetID, ingestionID, r.CreateTimeout(ctx, plan.Timeouts))
+
+		if ingestion != nil {
+			plan.IngestionStatus = flex.StringValueToFramework(ctx, ingestion.IngestionStatus)
+			plan.setRowInfo(ctx, ingestion.RowInfo)
+		}
+
+		if err != nil {
+			// Save the ingestion to state so that it is tainted rather than orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, resNameIngestion, plan.IngestionID.String(), nil),
+				err.Error(),
+			)
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -169,6 +227,12 @@ func (r *ingestionResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.IngestionStatus = flex.StringValueToFramework(ctx, out.IngestionStatus)
 	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
 	state.DataSetID = flex.StringValueToFramework(ctx, dataSetID)
+	state.setRowInfo(ctx, out.RowInfo)
+
+	// wait_for_completion only affects Create. Default it after import so the next plan is empty.
+	if state.WaitForCompletion.IsNull() {
+		state.WaitForCompletion = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -240,6 +304,85 @@ func findIngestion(ctx context.Context, conn *quicksight.Client, input *quicksig
 	return output.Ingestion, nil
 }
 
+// findLatestIngestionByTwoPartKey returns the most recently created ingestion for the specified data set.
+func findLatestIngestionByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, dataSetID string) (*awstypes.Ingestion, error) {
+	input := &quicksight.ListIngestionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}
+	var latest *awstypes.Ingestion
+
+	err := listPages(ctx, quicksight.NewListIngestionsPaginator(conn, input), func(page *quicksight.ListIngestionsOutput) bool {
+		for _, v := range page.Ingestions {
+			if latest == nil || aws.ToTime(v.CreatedTime).After(aws.ToTime(latest.CreatedTime)) {
+				latest = &v
+			}
+		}
+
+		return true
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if latest == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return latest, nil
+}
+
+func statusIngestion(ctx context.Context, conn *quicksight.Client, awsAccountID, dataSetID, ingestionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIngestionByThreePartKey(ctx, conn, awsAccountID, dataSetID, ingestionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.IngestionStatus), nil
+	}
+}
+
+func waitIngestionCompleted(ctx context.Context, conn *quicksight.Client, awsAccountID, dataSetID, ingestionID string, timeout time.Duration) (*awstypes.Ingestion, error) {
+	return waitIngestion(ctx, statusIngestion(ctx, conn, awsAccountID, dataSetID, ingestionID), timeout)
+}
+
+// waitIngestion waits for an ingestion to complete, surfacing the ingestion's
+// error information if it instead fails or is cancelled.
+func waitIngestion(ctx context.Context, refresh retry.StateRefreshFunc, timeout time.Duration) (*awstypes.Ingestion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngestionStatusInitialized, awstypes.IngestionStatusQueued, awstypes.IngestionStatusRunning),
+		Target:  enum.Slice(awstypes.IngestionStatusCompleted),
+		Refresh: refresh,
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Ingestion); ok {
+		if v := output.ErrorInfo; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", v.Type, aws.ToString(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const ingestionResourceIDSeparator = ","
 
 func ingestionCreateResourceID(awsAccountID, dataSetID, ingestionID string) string {
@@ -260,11 +403,26 @@ func ingestionParseResourceID(id string) (string, string, string, error) {
 }
 
 type resourceIngestionData struct {
-	ARN             types.String `tfsdk:"arn"`
-	AWSAccountID    types.String `tfsdk:"aws_account_id"`
-	DataSetID       types.String `tfsdk:"data_set_id"`
-	ID              types.String `tfsdk:"id"`
-	IngestionID     types.String `tfsdk:"ingestion_id"`
-	IngestionStatus types.String `tfsdk:"ingestion_status"`
-	IngestionType   types.String `tfsdk:"ingestion_type"`
+	ARN               types.String   `tfsdk:"arn"`
+	AWSAccountID      types.String   `tfsdk:"aws_account_id"`
+	DataSetID         types.String   `tfsdk:"data_set_id"`
+	ID                types.String   `tfsdk:"id"`
+	IngestionID       types.String   `tfsdk:"ingestion_id"`
+	IngestionStatus   types.String   `tfsdk:"ingestion_status"`
+	IngestionType     types.String   `tfsdk:"ingestion_type"`
+	RowsDropped       types.Int64    `tfsdk:"rows_dropped"`
+	RowsIngested      types.Int64    `tfsdk:"rows_ingested"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+}
+
+func (data *resourceIngestionData) setRowInfo(ctx context.Context, apiObject *awstypes.RowInfo) {
+	if apiObject == nil {
+		data.RowsDropped = types.Int64Null()
+		data.RowsIngested = types.Int64Null()
+		return
+	}
+
+	data.RowsDropped = flex.Int64ToFramework(ctx, apiObject.RowsDropped)
+	data.RowsIngested = flex.Int64ToFramework(ctx, apiObject.RowsIngested)
 }
@@ -7,8 +7,13 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -51,6 +56,8 @@ func TestAccQuickSightIngestion_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"ingestion_status",
 					"ingestion_type",
+					"rows_dropped",
+					"rows_ingested",
 				},
 			},
 		},
@@ -86,6 +93,211 @@ func TestAccQuickSightIngestion_disappears_dataSet(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightIngestion_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ingestion awstypes.Ingestion
+	resourceName := "aws_quicksight_ingestion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_waitForCompletion(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &ingestion),
+					resource.TestCheckResourceAttr(resourceName, "ingestion_status", string(awstypes.IngestionStatusCompleted)),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "rows_dropped"),
+					resource.TestCheckResourceAttrSet(resourceName, "rows_ingested"),
+				),
+			},
+		},
+	})
+}
+
+func TestWaitIngestion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statuses      []awstypes.IngestionStatus
+		errorInfo     *awstypes.ErrorInfo
+		expectedError string
+	}{
+		"completed": {
+			statuses: []awstypes.IngestionStatus{awstypes.IngestionStatusInitialized, awstypes.IngestionStatusQueued, awstypes.IngestionStatusRunning, awstypes.IngestionStatusCompleted},
+		},
+		"failed": {
+			statuses: []awstypes.IngestionStatus{awstypes.IngestionStatusQueued, awstypes.IngestionStatusRunning, awstypes.IngestionStatusFailed},
+			errorInfo: &awstypes.ErrorInfo{
+				Message: aws.String("The data source could not be reached"),
+				Type:    awstypes.IngestionErrorTypeDataSourceConnectionFailed,
+			},
+			expectedError: "DATA_SOURCE_CONNECTION_FAILED: The data source could not be reached",
+		},
+		"cancelled": {
+			statuses:      []awstypes.IngestionStatus{awstypes.IngestionStatusRunning, awstypes.IngestionStatusCancelled},
+			expectedError: "unexpected state 'CANCELLED'",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var polls int
+			refresh := func() (interface{}, string, error) {
+				status := testCase.statuses[polls]
+				polls++
+
+				output := &awstypes.Ingestion{
+					IngestionStatus: status,
+					RowInfo: &awstypes.RowInfo{
+						RowsDropped:  aws.Int64(1),
+						RowsIngested: aws.Int64(41),
+					},
+				}
+				if status == awstypes.IngestionStatusFailed {
+					output.ErrorInfo = testCase.errorInfo
+				}
+
+				return output, string(status), nil
+			}
+
+			output, err := tfquicksight.WaitIngestion(context.Background(), refresh, time.Minute)
+
+			if output == nil {
+				t.Fatal("expected ingestion, got none")
+			}
+
+			if got, want := aws.ToInt64(output.RowInfo.RowsIngested), int64(41); got != want {
+				t.Errorf("RowsIngested = %d, want %d", got, want)
+			}
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("error = %q, want it to contain %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestFindLatestIngestionByTwoPartKey(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := map[string]struct {
+		pages            [][]awstypes.Ingestion
+		notFound         bool
+		expectedID       string
+		expectedStatus   awstypes.IngestionStatus
+		expectedNotFound bool
+	}{
+		"latest failed": {
+			pages: [][]awstypes.Ingestion{
+				{
+					{IngestionId: aws.String("first"), IngestionStatus: awstypes.IngestionStatusCompleted, CreatedTime: aws.Time(now.Add(-2 * time.Hour))},
+				},
+				{
+					{IngestionId: aws.String("third"), IngestionStatus: awstypes.IngestionStatusFailed, CreatedTime: aws.Time(now), ErrorInfo: &awstypes.ErrorInfo{
+						Message: aws.String("The data source could not be reached"),
+						Type:    awstypes.IngestionErrorTypeDataSourceConnectionFailed,
+					}},
+					{IngestionId: aws.String("second"), IngestionStatus: awstypes.IngestionStatusCompleted, CreatedTime: aws.Time(now.Add(-1 * time.Hour))},
+				},
+			},
+			expectedID:     "third",
+			expectedStatus: awstypes.IngestionStatusFailed,
+		},
+		"latest completed": {
+			pages: [][]awstypes.Ingestion{
+				{
+					{IngestionId: aws.String("first"), IngestionStatus: awstypes.IngestionStatusFailed, CreatedTime: aws.Time(now.Add(-1 * time.Hour))},
+					{IngestionId: aws.String("second"), IngestionStatus: awstypes.IngestionStatusCompleted, CreatedTime: aws.Time(now)},
+				},
+			},
+			expectedID:     "second",
+			expectedStatus: awstypes.IngestionStatusCompleted,
+		},
+		"no ingestions": {
+			pages:            [][]awstypes.Ingestion{{}},
+			expectedNotFound: true,
+		},
+		"data set not found": {
+			notFound:         true,
+			expectedNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newTestClient(func(input any) (any, error) {
+				v, ok := input.(*quicksight.ListIngestionsInput)
+				if !ok {
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+
+				if testCase.notFound {
+					return nil, &awstypes.ResourceNotFoundException{Message: aws.String("data set not found")}
+				}
+
+				var page int
+				if token := aws.ToString(v.NextToken); token != "" {
+					var err error
+					if page, err = strconv.Atoi(token); err != nil {
+						return nil, err
+					}
+				}
+
+				output := &quicksight.ListIngestionsOutput{Ingestions: testCase.pages[page]}
+				if page+1 < len(testCase.pages) {
+					output.NextToken = aws.String(strconv.Itoa(page + 1))
+				}
+
+				return output, nil
+			})
+
+			output, err := tfquicksight.FindLatestIngestionByTwoPartKey(context.Background(), conn, "123456789012", "data-set")
+
+			if testCase.expectedNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected not found error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.IngestionId), testCase.expectedID; got != want {
+				t.Errorf("IngestionId = %q, want %q", got, want)
+			}
+
+			if got, want := output.IngestionStatus, testCase.expectedStatus; got != want {
+				t.Errorf("IngestionStatus = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func testAccCheckIngestionExists(ctx context.Context, n string, v *awstypes.Ingestion) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -182,3 +394,16 @@ resource "aws_quicksight_ingestion" "test" {
 }
 `, rId, rName, ingestionType))
 }
+
+func testAccIngestionConfig_waitForCompletion(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccIngestionConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_ingestion" "test" {
+  data_set_id         = aws_quicksight_data_set.test.data_set_id
+  ingestion_id        = %[1]q
+  ingestion_type      = "FULL_REFRESH"
+  wait_for_completion = true
+}
+`, rId))
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -82,6 +84,11 @@ func (r *namespaceResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			names.AttrForceDestroy: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			names.AttrID: framework.IDAttribute(),
 			"identity_store": schema.StringAttribute{
 				Optional: true,
@@ -99,6 +106,11 @@ func (r *namespaceResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"warn_on_capacity_region_mismatch": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
@@ -160,6 +172,10 @@ func (r *namespaceResource) Create(ctx context.Context, req resource.CreateReque
 	plan.CreationStatus = flex.StringValueToFramework(ctx, waitOut.CreationStatus)
 	plan.IdentityStore = flex.StringValueToFramework(ctx, waitOut.IdentityStore)
 
+	if plan.WarnOnCapacityRegionMismatch.ValueBool() {
+		addNamespaceCapacityRegionWarning(&resp.Diagnostics, plan.ID.ValueString(), r.Meta().Region, aws.ToString(waitOut.CapacityRegion))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -197,9 +213,20 @@ func (r *namespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.ARN = flex.StringToFramework(ctx, out.Arn)
 	state.CapacityRegion = flex.StringToFramework(ctx, out.CapacityRegion)
 	state.CreationStatus = flex.StringValueToFramework(ctx, out.CreationStatus)
+	// force_destroy isn't returned by the API, so default it for imported resources.
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
 	state.IdentityStore = flex.StringValueToFramework(ctx, out.IdentityStore)
 	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
 	state.Namespace = flex.StringValueToFramework(ctx, namespace)
+	if state.WarnOnCapacityRegionMismatch.IsNull() {
+		state.WarnOnCapacityRegionMismatch = types.BoolValue(false)
+	}
+
+	if state.WarnOnCapacityRegionMismatch.ValueBool() {
+		addNamespaceCapacityRegionWarning(&resp.Diagnostics, state.ID.ValueString(), r.Meta().Region, aws.ToString(out.CapacityRegion))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -222,6 +249,25 @@ func (r *namespaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.ForceDestroy.ValueBool() {
+		removed, err := deleteNamespaceIdentities(ctx, conn, awsAccountID, namespace)
+
+		if len(removed) > 0 {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("QuickSight Namespace (%s) identities removed", state.ID.ValueString()),
+				fmt.Sprintf("force_destroy removed the following identities: %s", strings.Join(removed, ", ")),
+			)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, resNameNamespace, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	_, err = conn.DeleteNamespace(ctx, &quicksight.DeleteNamespaceInput{
 		AwsAccountId: aws.String(awsAccountID),
 		Namespace:    aws.String(namespace),
@@ -252,6 +298,92 @@ func (r *namespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	r.SetTagsAll(ctx, req, resp)
 }
 
+// addNamespaceCapacityRegionWarning warns when the namespace's SPICE capacity lives outside the provider's Region.
+func addNamespaceCapacityRegionWarning(diags *diag.Diagnostics, id, providerRegion, capacityRegion string) {
+	if capacityRegion == "" || capacityRegion == providerRegion {
+		return
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("QuickSight Namespace (%s) capacity Region mismatch", id),
+		fmt.Sprintf("The namespace's capacity Region (%s) differs from the provider Region (%s). SPICE data for the namespace is stored in %[1]s, which can increase latency for resources in %[2]s.", capacityRegion, providerRegion),
+	)
+}
+
+// deleteNamespaceIdentities deletes all groups and users in the namespace.
+// It returns the identities that were removed, e.g. group/example and user/example.
+func deleteNamespaceIdentities(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string) ([]string, error) {
+	var groupNames, userNames, removed []string
+
+	err := listPages(ctx, quicksight.NewListGroupsPaginator(conn, &quicksight.ListGroupsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	}), func(page *quicksight.ListGroupsOutput) bool {
+		for _, v := range page.GroupList {
+			groupNames = append(groupNames, aws.ToString(v.GroupName))
+		}
+
+		return true
+	})
+
+	if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return removed, fmt.Errorf("listing QuickSight Groups: %w", err)
+	}
+
+	for _, groupName := range groupNames {
+		_, err := conn.DeleteGroup(ctx, &quicksight.DeleteGroupInput{
+			AwsAccountId: aws.String(awsAccountID),
+			GroupName:    aws.String(groupName),
+			Namespace:    aws.String(namespace),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return removed, fmt.Errorf("deleting QuickSight Group (%s): %w", groupName, err)
+		}
+
+		removed = append(removed, "group/"+groupName)
+	}
+
+	err = listPages(ctx, quicksight.NewListUsersPaginator(conn, &quicksight.ListUsersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	}), func(page *quicksight.ListUsersOutput) bool {
+		for _, v := range page.UserList {
+			userNames = append(userNames, aws.ToString(v.UserName))
+		}
+
+		return true
+	})
+
+	if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return removed, fmt.Errorf("listing QuickSight Users: %w", err)
+	}
+
+	for _, userName := range userNames {
+		_, err := conn.DeleteUser(ctx, &quicksight.DeleteUserInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+			UserName:     aws.String(userName),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return removed, fmt.Errorf("deleting QuickSight User (%s): %w", userName, err)
+		}
+
+		removed = append(removed, "user/"+userName)
+	}
+
+	return removed, nil
+}
+
 func findNamespaceByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string) (*awstypes.NamespaceInfoV2, error) {
 	input := &quicksight.DescribeNamespaceInput{
 		AwsAccountId: aws.String(awsAccountID),
@@ -282,6 +414,42 @@ func findNamespace(ctx context.Context, conn *quicksight.Client, input *quicksig
 	return output.Namespace, nil
 }
 
+// isNamespaceNotFoundError returns whether err is a ResourceNotFoundException
+// raised because the namespace itself, rather than the group or user being
+// operated on, does not exist.
+func isNamespaceNotFoundError(err error) bool {
+	v, ok := errs.As[*awstypes.ResourceNotFoundException](err)
+	if !ok {
+		return false
+	}
+
+	if v.ResourceType != "" {
+		return v.ResourceType == awstypes.ExceptionResourceTypeNamespace
+	}
+
+	return strings.HasPrefix(strings.ToLower(v.ErrorMessage()), "namespace")
+}
+
+// namespaceNotFoundError returns err annotated with the missing namespace if
+// it is a namespace ResourceNotFoundException, otherwise err unchanged.
+func namespaceNotFoundError(err error, namespace string) error {
+	if isNamespaceNotFoundError(err) {
+		return fmt.Errorf("QuickSight Namespace (%s) not found: %w", namespace, err)
+	}
+
+	return err
+}
+
+// namespacedResourceNotFoundMessage returns the message for a not found
+// error returned while reading a group or user from a namespace.
+func namespacedResourceNotFoundMessage(err error, namespace, resourceType, name string) string {
+	if isNamespaceNotFoundError(err) {
+		return fmt.Sprintf("QuickSight Namespace (%s) not found", namespace)
+	}
+
+	return fmt.Sprintf("QuickSight %s (%s) not found in namespace (%s)", resourceType, name, namespace)
+}
+
 const namespaceResourceIDSeparator = ","
 
 func namespaceCreateResourceID(awsAccountID, namespace string) string {
@@ -302,23 +470,28 @@ func namespaceParseResourceID(id string) (string, string, error) {
 }
 
 type resourceNamespaceData struct {
-	ARN            types.String   `tfsdk:"arn"`
-	AWSAccountID   types.String   `tfsdk:"aws_account_id"`
-	CapacityRegion types.String   `tfsdk:"capacity_region"`
-	CreationStatus types.String   `tfsdk:"creation_status"`
-	ID             types.String   `tfsdk:"id"`
-	IdentityStore  types.String   `tfsdk:"identity_store"`
-	Namespace      types.String   `tfsdk:"namespace"`
-	Tags           tftags.Map     `tfsdk:"tags"`
-	TagsAll        tftags.Map     `tfsdk:"tags_all"`
-	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	ARN                          types.String   `tfsdk:"arn"`
+	AWSAccountID                 types.String   `tfsdk:"aws_account_id"`
+	CapacityRegion               types.String   `tfsdk:"capacity_region"`
+	CreationStatus               types.String   `tfsdk:"creation_status"`
+	ForceDestroy                 types.Bool     `tfsdk:"force_destroy"`
+	ID                           types.String   `tfsdk:"id"`
+	IdentityStore                types.String   `tfsdk:"identity_store"`
+	Namespace                    types.String   `tfsdk:"namespace"`
+	Tags                         tftags.Map     `tfsdk:"tags"`
+	TagsAll                      tftags.Map     `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+	WarnOnCapacityRegionMismatch types.Bool     `tfsdk:"warn_on_capacity_region_mismatch"`
 }
 
+// namespaceCreateMaxRetryableFailures is the number of consecutive RETRYABLE_FAILURE statuses tolerated while waiting for a namespace to be created.
+const namespaceCreateMaxRetryableFailures = 3
+
 func waitNamespaceCreated(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string, timeout time.Duration) (*awstypes.NamespaceInfoV2, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.NamespaceStatusCreating),
+		Pending:    enum.Slice(awstypes.NamespaceStatusCreating, awstypes.NamespaceStatusRetryableFailure),
 		Target:     enum.Slice(awstypes.NamespaceStatusCreated),
-		Refresh:    statusNamespace(ctx, conn, awsAccountID, namespace),
+		Refresh:    statusNamespaceCreate(statusNamespace(ctx, conn, awsAccountID, namespace), namespaceCreateMaxRetryableFailures),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 	}
@@ -350,6 +523,43 @@ func waitNamespaceDeleted(ctx context.Context, conn *quicksight.Client, awsAccou
 	return nil, err
 }
 
+// statusNamespaceCreate wraps a namespace status refresh function for use while waiting for creation.
+// RETRYABLE_FAILURE is reported as pending until it has been seen more than maxRetryableFailures times in a row,
+// and NON_RETRYABLE_FAILURE is terminal. In both cases the namespace error is surfaced.
+func statusNamespaceCreate(refresh retry.StateRefreshFunc, maxRetryableFailures int) retry.StateRefreshFunc {
+	var retryableFailures int
+
+	return func() (interface{}, string, error) {
+		outputRaw, status, err := refresh()
+
+		if err != nil {
+			return outputRaw, status, err
+		}
+
+		switch awstypes.NamespaceStatus(status) {
+		case awstypes.NamespaceStatusRetryableFailure:
+			retryableFailures++
+			if retryableFailures > maxRetryableFailures {
+				return outputRaw, status, fmt.Errorf("%s after %d attempts: %w", status, retryableFailures, namespaceError(outputRaw))
+			}
+		case awstypes.NamespaceStatusNonRetryableFailure:
+			return outputRaw, status, fmt.Errorf("%s: %w", status, namespaceError(outputRaw))
+		default:
+			retryableFailures = 0
+		}
+
+		return outputRaw, status, nil
+	}
+}
+
+func namespaceError(outputRaw interface{}) error {
+	if output, ok := outputRaw.(*awstypes.NamespaceInfoV2); ok && output.NamespaceError != nil {
+		return fmt.Errorf("%s: %s", output.NamespaceError.Type, aws.ToString(output.NamespaceError.Message))
+	}
+
+	return errors.New("unknown namespace error")
+}
+
 func statusNamespace(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findNamespaceByTwoPartKey(ctx, conn, awsAccountID, namespace)
@@ -5,10 +5,14 @@ package quicksight_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -19,6 +23,181 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestStatusNamespaceCreate(t *testing.T) {
+	t.Parallel()
+
+	namespaceError := &awstypes.NamespaceError{
+		Message: aws.String("failure message"),
+		Type:    awstypes.NamespaceErrorTypeInternalServiceError,
+	}
+
+	testCases := map[string]struct {
+		statuses      []awstypes.NamespaceStatus
+		expectedError string
+	}{
+		"created": {
+			statuses: []awstypes.NamespaceStatus{awstypes.NamespaceStatusCreating, awstypes.NamespaceStatusCreated},
+		},
+		"retryable failure recovers": {
+			statuses: []awstypes.NamespaceStatus{awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusCreated},
+		},
+		"retryable failure count resets": {
+			statuses: []awstypes.NamespaceStatus{awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusCreating, awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusCreated},
+		},
+		"retryable failure exceeds retries": {
+			statuses:      []awstypes.NamespaceStatus{awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusRetryableFailure, awstypes.NamespaceStatusRetryableFailure},
+			expectedError: "RETRYABLE_FAILURE after 3 attempts: INTERNAL_SERVICE_ERROR: failure message",
+		},
+		"non-retryable failure": {
+			statuses:      []awstypes.NamespaceStatus{awstypes.NamespaceStatusCreating, awstypes.NamespaceStatusNonRetryableFailure},
+			expectedError: "NON_RETRYABLE_FAILURE: INTERNAL_SERVICE_ERROR: failure message",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var polls int
+			refresh := tfquicksight.StatusNamespaceCreate(func() (interface{}, string, error) {
+				status := testCase.statuses[polls]
+				polls++
+
+				output := &awstypes.NamespaceInfoV2{CreationStatus: status}
+				if status == awstypes.NamespaceStatusRetryableFailure || status == awstypes.NamespaceStatusNonRetryableFailure {
+					output.NamespaceError = namespaceError
+				}
+
+				return output, string(status), nil
+			}, 2)
+
+			var err error
+			for range testCase.statuses {
+				if _, _, err = refresh(); err != nil {
+					break
+				}
+			}
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+
+			if got, want := polls, len(testCase.statuses); got != want {
+				t.Errorf("polls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAddNamespaceCapacityRegionWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		capacityRegion string
+		expectWarning  bool
+	}{
+		"same Region": {
+			capacityRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"different Region": {
+			capacityRegion: "us-east-1", //lintignore:AWSAT003
+			expectWarning:  true,
+		},
+		"not returned": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			tfquicksight.AddNamespaceCapacityRegionWarning(&diags, "123456789012,example", "us-west-2", testCase.capacityRegion) //lintignore:AWSAT003
+
+			if got, want := diags.WarningsCount() > 0, testCase.expectWarning; got != want {
+				t.Errorf("warnings = %v, expectWarning = %t", diags.Warnings(), want)
+			}
+			if diags.HasError() {
+				t.Errorf("unexpected errors: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestNamespacedResourceNotFoundMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err             error
+		resourceType    string
+		expectedMessage string
+		expectNamespace bool
+	}{
+		"namespace": {
+			err: &awstypes.ResourceNotFoundException{
+				Message:      aws.String("Namespace example not found in account 123456789012"),
+				ResourceType: awstypes.ExceptionResourceTypeNamespace,
+			},
+			resourceType:    "Group",
+			expectedMessage: "QuickSight Namespace (example) not found",
+			expectNamespace: true,
+		},
+		"namespace without resource type": {
+			err: &awstypes.ResourceNotFoundException{
+				Message: aws.String("Namespace example not found in account 123456789012"),
+			},
+			resourceType:    "User",
+			expectedMessage: "QuickSight Namespace (example) not found",
+			expectNamespace: true,
+		},
+		"group": {
+			err: &awstypes.ResourceNotFoundException{
+				Message:      aws.String("Group name1 not found in namespace example"),
+				ResourceType: awstypes.ExceptionResourceTypeGroup,
+			},
+			resourceType:    "Group",
+			expectedMessage: "QuickSight Group (name1) not found in namespace (example)",
+		},
+		"user": {
+			err: &awstypes.ResourceNotFoundException{
+				Message:      aws.String("User name1 not found in namespace example"),
+				ResourceType: awstypes.ExceptionResourceTypeUser,
+			},
+			resourceType:    "User",
+			expectedMessage: "QuickSight User (name1) not found in namespace (example)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfquicksight.NamespacedResourceNotFoundMessage(testCase.err, "example", testCase.resourceType, "name1"), testCase.expectedMessage; got != want {
+				t.Errorf("message = %q, want %q", got, want)
+			}
+
+			err := tfquicksight.NamespaceNotFoundError(testCase.err, "example")
+
+			if got, want := err != testCase.err, testCase.expectNamespace; got != want {
+				t.Errorf("annotated = %t, want %t (%s)", got, want, err)
+			}
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("error %q does not wrap %q", err, testCase.err)
+			}
+		})
+	}
+}
+
 func TestAccQuickSightNamespace_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var namespace awstypes.NamespaceInfoV2
@@ -38,6 +217,8 @@ func TestAccQuickSightNamespace_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrNamespace, rName),
 					resource.TestCheckResourceAttr(resourceName, "identity_store", string(awstypes.IdentityStoreQuicksight)),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "quicksight", fmt.Sprintf("namespace/%[1]s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity_region"),
+					resource.TestCheckResourceAttr(resourceName, "warn_on_capacity_region_mismatch", acctest.CtFalse),
 				),
 			},
 			{
@@ -73,6 +254,55 @@ func TestAccQuickSightNamespace_disappears(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightNamespace_tagsOnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var namespace awstypes.NamespaceInfoV2
+	resourceName := "aws_quicksight_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(ctx, resourceName, &namespace),
+					// Tags are passed to CreateNamespace rather than added afterwards with TagResource.
+					testAccCheckTagsInAWS(ctx, resourceName, map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightNamespace_forceDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var namespace awstypes.NamespaceInfoV2
+	resourceName := "aws_quicksight_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfig_forceDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(ctx, resourceName, &namespace),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDestroy, acctest.CtTrue),
+					// Create a group outside of Terraform so that it is still in the namespace on destroy.
+					testAccCheckNamespaceCreateGroup(ctx, resourceName, rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNamespaceExists(ctx context.Context, n string, v *awstypes.NamespaceInfoV2) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -120,6 +350,25 @@ func testAccCheckNamespaceDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckNamespaceCreateGroup(ctx context.Context, n, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := conn.CreateGroup(ctx, &quicksight.CreateGroupInput{
+			AwsAccountId: aws.String(rs.Primary.Attributes[names.AttrAWSAccountID]),
+			GroupName:    aws.String(groupName),
+			Namespace:    aws.String(rs.Primary.Attributes[names.AttrNamespace]),
+		})
+
+		return err
+	}
+}
+
 func testAccNamespaceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_quicksight_namespace" "test" {
@@ -127,3 +376,24 @@ resource "aws_quicksight_namespace" "test" {
 }
 `, rName)
 }
+
+func testAccNamespaceConfig_forceDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_namespace" "test" {
+  namespace     = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccNamespaceConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_namespace" "test" {
+  namespace = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// paginator is implemented by the AWS SDK for Go v2 QuickSight List* paginators.
+type paginator[T any] interface {
+	HasMorePages() bool
+	NextPage(context.Context, ...func(*quicksight.Options)) (T, error)
+}
+
+// listPages iterates over all pages of a QuickSight List* paginator, calling fn for each page.
+// Pages that fail with a ThrottlingException are retried with backoff. The SDK paginators only
+// advance their pagination token on success, so a retried request fetches the same page again.
+// Iteration stops early if fn returns false.
+func listPages[T any](ctx context.Context, pages paginator[T], fn func(T) bool) error {
+	return listPagesWithBackoff(ctx, pages, fn)
+}
+
+// listPagesWithBackoff is listPages with options controlling the backoff between retries of a throttled page.
+func listPagesWithBackoff[T any](ctx context.Context, pages paginator[T], fn func(T) bool, optFns ...tfresource.OptionsFunc) error {
+	for pages.HasMorePages() {
+		var output T
+
+		err := tfresource.Retry(ctx, listThrottlingTimeout, func() *retry.RetryError {
+			var err error
+
+			output, err = pages.NextPage(ctx)
+
+			if errs.IsA[*awstypes.ThrottlingException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			return nil
+		}, optFns...)
+
+		if tfresource.TimedOut(err) {
+			output, err = pages.NextPage(ctx)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !fn(output) {
+			return nil
+		}
+	}
+
+	return nil
+}
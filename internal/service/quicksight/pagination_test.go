@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// mockVPCConnectionsPaginator mimics the SDK paginators: the page index only advances on success.
+type mockVPCConnectionsPaginator struct {
+	pages    []*quicksight.ListVPCConnectionsOutput
+	errs     map[int][]error // Errors to return, in order, before each page succeeds.
+	page     int
+	requests int
+}
+
+func (p *mockVPCConnectionsPaginator) HasMorePages() bool {
+	return p.page < len(p.pages)
+}
+
+func (p *mockVPCConnectionsPaginator) NextPage(context.Context, ...func(*quicksight.Options)) (*quicksight.ListVPCConnectionsOutput, error) {
+	p.requests++
+
+	if errs := p.errs[p.page]; len(errs) > 0 {
+		p.errs[p.page] = errs[1:]
+		return nil, errs[0]
+	}
+
+	output := p.pages[p.page]
+	p.page++
+
+	return output, nil
+}
+
+func vpcConnectionsPage(ids ...string) *quicksight.ListVPCConnectionsOutput {
+	output := &quicksight.ListVPCConnectionsOutput{}
+
+	for _, id := range ids {
+		output.VPCConnectionSummaries = append(output.VPCConnectionSummaries, awstypes.VPCConnectionSummary{
+			VPCConnectionId: aws.String(id),
+		})
+	}
+
+	return output
+}
+
+func TestListPages(t *testing.T) {
+	t.Parallel()
+
+	throttled := &awstypes.ThrottlingException{Message: aws.String("Rate exceeded")}
+
+	testCases := map[string]struct {
+		errs             map[int][]error
+		expectedIDs      []string
+		expectedRequests int
+		expectError      bool
+	}{
+		"no errors": {
+			expectedIDs:      []string{"a", "b", "c", "d", "e"},
+			expectedRequests: 3,
+		},
+		"throttled between pages": {
+			errs: map[int][]error{
+				1: {throttled, throttled},
+			},
+			expectedIDs:      []string{"a", "b", "c", "d", "e"},
+			expectedRequests: 5,
+		},
+		"throttled on every page": {
+			errs: map[int][]error{
+				0: {throttled},
+				1: {throttled},
+				2: {throttled},
+			},
+			expectedIDs:      []string{"a", "b", "c", "d", "e"},
+			expectedRequests: 6,
+		},
+		"other error": {
+			errs: map[int][]error{
+				1: {errors.New("boom")},
+			},
+			expectedIDs:      []string{"a", "b"},
+			expectedRequests: 2,
+			expectError:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pages := &mockVPCConnectionsPaginator{
+				pages: []*quicksight.ListVPCConnectionsOutput{
+					vpcConnectionsPage("a", "b"),
+					vpcConnectionsPage("c", "d"),
+					vpcConnectionsPage("e"),
+				},
+				errs: testCase.errs,
+			}
+
+			var ids []string
+			// Retry throttled pages immediately rather than waiting out the backoff.
+			err := tfquicksight.ListVPCConnectionsPagesWithBackoff(context.Background(), pages, func(page *quicksight.ListVPCConnectionsOutput) bool {
+				for _, v := range page.VPCConnectionSummaries {
+					ids = append(ids, aws.ToString(v.VPCConnectionId))
+				}
+
+				return true
+			}, tfresource.WithPollInterval(time.Millisecond))
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, expectError = %t", err, want)
+			}
+
+			if got, want := len(ids), len(testCase.expectedIDs); got != want {
+				t.Fatalf("ids = %v, want %v", ids, testCase.expectedIDs)
+			}
+
+			for i := range ids {
+				if got, want := ids[i], testCase.expectedIDs[i]; got != want {
+					t.Errorf("ids = %v, want %v", ids, testCase.expectedIDs)
+					break
+				}
+			}
+
+			if got, want := pages.requests, testCase.expectedRequests; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -4,10 +4,19 @@
 package quicksight_test
 
 import (
+	"context"
+	"fmt"
+	"maps"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -26,10 +35,75 @@ func TestAccQuickSight_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"AccountSubscription": {
-			acctest.CtBasic:      testAccAccountSubscription_basic,
-			acctest.CtDisappears: testAccAccountSubscription_disappears,
+			acctest.CtBasic:         testAccAccountSubscription_basic,
+			"dataSource":            testAccAccountSubscriptionDataSource_basic,
+			acctest.CtDisappears:    testAccAccountSubscription_disappears,
+			"iamIdentityCenter":     testAccAccountSubscription_iamIdentityCenter,
+			"notificationEmail":     testAccAccountSubscription_notificationEmail,
+			"terminationProtection": testAccAccountSubscription_terminationProtection,
+			"validationOnlyEnabled": testAccAccountSubscription_validationOnlyEnabled,
 		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
 }
+
+// testAccCheckTagsInAWS checks the tags on the resource's ARN as reported by ListTagsForResource, rather than the tags in state.
+func testAccCheckTagsInAWS(ctx context.Context, n string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		tags, err := tfquicksight.ListTags(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		if got := tags.IgnoreAWS().Map(); !maps.Equal(got, want) {
+			return fmt.Errorf("%s tags = %v, want %v", n, got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAPIHandler answers an API request in place of the service. It's passed the operation input and returns
+// the operation output.
+type testAPIHandler func(input any) (any, error)
+
+// newTestClient returns a QuickSight client whose requests are answered by handler and never sent.
+func newTestClient(handler testAPIHandler) *quicksight.Client {
+	return quicksight.New(quicksight.Options{
+		Region:     "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{addTestAPIHandlerMiddleware(handler)},
+	})
+}
+
+// newTestDirectoryServiceClient returns a Directory Service client whose requests are answered by handler and never sent.
+func newTestDirectoryServiceClient(handler testAPIHandler) *directoryservice.Client {
+	return directoryservice.New(directoryservice.Options{
+		Region:     "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{addTestAPIHandlerMiddleware(handler)},
+	})
+}
+
+func addTestAPIHandlerMiddleware(handler testAPIHandler) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(
+			middleware.InitializeMiddlewareFunc(
+				"Test: API Handler",
+				func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+					output, err := handler(in.Parameters)
+
+					return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, err
+				},
+			),
+			middleware.Before,
+		)
+	}
+}
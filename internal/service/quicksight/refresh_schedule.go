@@ -138,6 +138,9 @@ func (r *refreshScheduleResource) Schema(ctx context.Context, req resource.Schem
 									"timezone": schema.StringAttribute{
 										Optional: true,
 										Computed: true,
+										Validators: []validator.String{
+											timezoneValidator{},
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -598,3 +601,47 @@ func (v timeMatchesValidator) ValidateString(ctx context.Context, request valida
 		))
 	}
 }
+
+// timezoneDatabaseAvailable returns whether the host has an IANA Time Zone database that time.LoadLocation can use.
+func timezoneDatabaseAvailable() bool {
+	_, err := time.LoadLocation("America/New_York")
+	return err == nil
+}
+
+// timezoneValidator validates that a string is a time zone name from the IANA Time Zone database.
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be a valid IANA time zone name, e.g. America/New_York"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	// time.LoadLocation maps "" to UTC and "Local" to the host's time zone, neither of which QuickSight accepts.
+	if value == "" || value == "Local" {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+		return
+	}
+
+	// Names are checked against the host's time zone database. Without one, leave validation to QuickSight.
+	if _, err := time.LoadLocation(value); err != nil && timezoneDatabaseAvailable() {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
@@ -335,6 +335,35 @@ func TestAccQuickSightRefreshSchedule_invalidRefreshInterval(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightRefreshSchedule_timezone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var schedule awstypes.RefreshSchedule
+	resourceName := "aws_quicksight_refresh_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRefreshScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRefreshScheduleConfig_timezone(rId, rName, sId, "America/NewYork"),
+				ExpectError: regexache.MustCompile(`value must be a valid IANA time zone name`),
+			},
+			{
+				Config: testAccRefreshScheduleConfig_timezone(rId, rName, sId, "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRefreshScheduleExists(ctx, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.schedule_frequency.0.timezone", "America/New_York"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightRefreshSchedule_startAfterDateTime(t *testing.T) {
 	ctx := acctest.Context(t)
 	var schedule awstypes.RefreshSchedule
@@ -665,6 +694,25 @@ resource "aws_quicksight_refresh_schedule" "test" {
 `, sId, interval))
 }
 
+func testAccRefreshScheduleConfig_timezone(rId, rName, sId, timezone string) string {
+	return acctest.ConfigCompose(
+		testAccRefreshScheduleConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_refresh_schedule" "test" {
+  data_set_id = aws_quicksight_data_set.test.data_set_id
+  schedule_id = %[1]q
+  schedule {
+    refresh_type = "FULL_REFRESH"
+    schedule_frequency {
+      interval        = "DAILY"
+      time_of_the_day = "12:00"
+      timezone        = %[2]q
+    }
+  }
+}
+`, sId, timezone))
+}
+
 func testAccRefreshScheduleConfig_startAfterDateTime(rId, rName, sId, startAfter string) string {
 	return acctest.ConfigCompose(
 		testAccRefreshScheduleConfig_base(rId, rName),
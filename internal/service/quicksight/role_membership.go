@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_quicksight_role_membership", name="Role Membership")
+func newRoleMembershipResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &roleMembershipResource{}, nil
+}
+
+const (
+	resNameRoleMembership = "Role Membership"
+)
+
+type roleMembershipResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *roleMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_quicksight_role_membership"
+}
+
+func (r *roleMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAWSAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"member_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGroupNamespace),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRole: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.Role](),
+				},
+			},
+		},
+	}
+}
+
+func (r *roleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var plan resourceRoleMembershipData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID)
+	}
+	awsAccountID, namespace, role, memberName := flex.StringValueFromFramework(ctx, plan.AWSAccountID), flex.StringValueFromFramework(ctx, plan.Namespace), flex.StringValueFromFramework(ctx, plan.Role), flex.StringValueFromFramework(ctx, plan.MemberName)
+	in := &quicksight.CreateRoleMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		MemberName:   aws.String(memberName),
+		Namespace:    aws.String(namespace),
+		Role:         awstypes.Role(role),
+	}
+
+	_, err := conn.CreateRoleMembership(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameRoleMembership, plan.MemberName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringValueToFramework(ctx, roleMembershipCreateResourceID(awsAccountID, namespace, role, memberName))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *roleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var state resourceRoleMembershipData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountID, namespace, role, memberName, err := roleMembershipParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameRoleMembership, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	err = findRoleMembershipByFourPartKey(ctx, conn, awsAccountID, namespace, awstypes.Role(role), memberName)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameRoleMembership, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
+	state.MemberName = flex.StringValueToFramework(ctx, memberName)
+	state.Namespace = flex.StringValueToFramework(ctx, namespace)
+	state.Role = flex.StringValueToFramework(ctx, role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var state resourceRoleMembershipData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountID, namespace, role, memberName, err := roleMembershipParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, resNameRoleMembership, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = conn.DeleteRoleMembership(ctx, &quicksight.DeleteRoleMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		MemberName:   aws.String(memberName),
+		Namespace:    aws.String(namespace),
+		Role:         awstypes.Role(role),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, resNameRoleMembership, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findRoleMembershipByFourPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string, role awstypes.Role, memberName string) error {
+	input := &quicksight.ListRoleMembershipsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		Role:         role,
+	}
+
+	output, err := findRoleMemberships(ctx, conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(output, memberName) {
+		return &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
+
+func findRoleMemberships(ctx context.Context, conn *quicksight.Client, input *quicksight.ListRoleMembershipsInput) ([]string, error) {
+	var output []string
+
+	err := listPages(ctx, quicksight.NewListRoleMembershipsPaginator(conn, input), func(page *quicksight.ListRoleMembershipsOutput) bool {
+		output = append(output, page.MembersList...)
+
+		return true
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+const roleMembershipResourceIDSeparator = ","
+
+func roleMembershipCreateResourceID(awsAccountID, namespace, role, memberName string) string {
+	parts := []string{awsAccountID, namespace, role, memberName}
+	id := strings.Join(parts, roleMembershipResourceIDSeparator)
+
+	return id
+}
+
+func roleMembershipParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, roleMembershipResourceIDSeparator, 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sNAMESPACE%[2]sROLE%[2]sMEMBER_NAME", id, roleMembershipResourceIDSeparator)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+type resourceRoleMembershipData struct {
+	AWSAccountID types.String `tfsdk:"aws_account_id"`
+	ID           types.String `tfsdk:"id"`
+	MemberName   types.String `tfsdk:"member_name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	Role         types.String `tfsdk:"role"`
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Role memberships can only be managed in accounts using IAM Identity Center or Active Directory.
+// QUICKSIGHT_ROLE_MEMBERSHIP_GROUP must be the name of a group in the account's identity source.
+const envVarRoleMembershipGroup = "QUICKSIGHT_ROLE_MEMBERSHIP_GROUP"
+
+func TestAccQuickSightRoleMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	memberName := acctest.SkipIfEnvVarNotSet(t, envVarRoleMembershipGroup)
+	resourceName := "aws_quicksight_role_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembershipConfig_basic(memberName, string(awstypes.RoleReader)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleMembershipExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttr(resourceName, "member_name", memberName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamespace, tfquicksight.DefaultGroupNamespace),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRole, string(awstypes.RoleReader)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoleMembershipConfig_basic(memberName, string(awstypes.RoleAuthor)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleMembershipExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRole, string(awstypes.RoleAuthor)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightRoleMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	memberName := acctest.SkipIfEnvVarNotSet(t, envVarRoleMembershipGroup)
+	resourceName := "aws_quicksight_role_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembershipConfig_basic(memberName, string(awstypes.RoleReader)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleMembershipExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceRoleMembership, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoleMembershipExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		return tfquicksight.FindRoleMembershipByFourPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes[names.AttrNamespace], awstypes.Role(rs.Primary.Attributes[names.AttrRole]), rs.Primary.Attributes["member_name"])
+	}
+}
+
+func testAccCheckRoleMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_role_membership" {
+				continue
+			}
+
+			err := tfquicksight.FindRoleMembershipByFourPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes[names.AttrNamespace], awstypes.Role(rs.Primary.Attributes[names.AttrRole]), rs.Primary.Attributes["member_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("QuickSight Role Membership (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRoleMembershipConfig_basic(memberName, role string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_role_membership" "test" {
+  member_name = %[1]q
+  role        = %[2]q
+}
+`, memberName, role)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validResourceARN returns a validator for the ARN of a QuickSight resource of the specified type, in any account or Region.
+func validResourceARN(resourceType, description string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		ws, errors = verify.ValidARN(v, k)
+		if len(errors) > 0 {
+			return ws, errors
+		}
+
+		parsedARN, _ := arn.Parse(value)
+
+		if parsedARN.Service != names.QuickSightEndpointID {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a QuickSight ARN", k, value))
+		}
+
+		if parsedARN.Region == "" {
+			errors = append(errors, fmt.Errorf("%q (%s) must include a Region", k, value))
+		}
+
+		if id, ok := strings.CutPrefix(parsedARN.Resource, resourceType+"/"); !ok || id == "" || strings.Contains(id, "/") {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a QuickSight %s ARN", k, value, description))
+		}
+
+		return ws, errors
+	}
+}
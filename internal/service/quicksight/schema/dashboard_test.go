@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestExpandDashboardPublishOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		tfList    []interface{}
+		expected  *awstypes.DashboardPublishOptions
+		flattened []interface{}
+	}{
+		{
+			name: "sheet layout element maximization",
+			tfList: []interface{}{map[string]interface{}{
+				"sheet_layout_element_maximization_option": []interface{}{map[string]interface{}{
+					"availability_status": string(awstypes.DashboardBehaviorEnabled),
+				}},
+			}},
+			expected: &awstypes.DashboardPublishOptions{
+				SheetLayoutElementMaximizationOption: &awstypes.SheetLayoutElementMaximizationOption{
+					AvailabilityStatus: awstypes.DashboardBehaviorEnabled,
+				},
+			},
+			flattened: []interface{}{map[string]interface{}{
+				"sheet_layout_element_maximization_option": []interface{}{map[string]interface{}{
+					"availability_status": awstypes.DashboardBehaviorEnabled,
+				}},
+			}},
+		},
+		{
+			name: "visual menu",
+			tfList: []interface{}{map[string]interface{}{
+				"visual_menu_option": []interface{}{map[string]interface{}{
+					"availability_status": string(awstypes.DashboardBehaviorDisabled),
+				}},
+			}},
+			expected: &awstypes.DashboardPublishOptions{
+				VisualMenuOption: &awstypes.VisualMenuOption{
+					AvailabilityStatus: awstypes.DashboardBehaviorDisabled,
+				},
+			},
+			flattened: []interface{}{map[string]interface{}{
+				"visual_menu_option": []interface{}{map[string]interface{}{
+					"availability_status": awstypes.DashboardBehaviorDisabled,
+				}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := ExpandDashboardPublishOptions(testCase.tfList)
+
+			if diff := cmp.Diff(apiObject, testCase.expected, cmpopts.IgnoreUnexported(awstypes.DashboardPublishOptions{}, awstypes.SheetLayoutElementMaximizationOption{}, awstypes.VisualMenuOption{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(FlattenDashboardPublishOptions(apiObject), testCase.flattened); diff != "" {
+				t.Errorf("unexpected flattened diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
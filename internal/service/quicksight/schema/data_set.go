@@ -4,6 +4,10 @@
 package schema
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -402,7 +406,14 @@ func DataSetPhysicalTableMapSchema() *schema.Schema {
 							},
 							"data_source_arn": arnStringSchema(attrRequired),
 							names.AttrName:    stringLenBetweenSchema(attrRequired, 1, 64),
-							"sql_query":       stringLenBetweenSchema(attrRequired, 1, 65536),
+							"sql_query": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 65536),
+									validation.StringIsNotWhiteSpace,
+								),
+							},
 						},
 					},
 				},
@@ -508,10 +519,10 @@ func DataSetRowLevelPermissionDataSetSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				names.AttrARN:       arnStringSchema(attrRequired),
-				"format_version":    stringEnumSchema[awstypes.RowLevelPermissionFormatVersion](attrOptional),
+				"format_version":    stringEnumSchema[awstypes.RowLevelPermissionFormatVersion](attrOptionalComputed),
 				names.AttrNamespace: stringLenBetweenSchema(attrOptional, 0, 64),
 				"permission_policy": stringEnumSchema[awstypes.RowLevelPermissionPolicy](attrRequired),
-				names.AttrStatus:    stringEnumSchema[awstypes.Status](attrOptional),
+				names.AttrStatus:    stringEnumSchema[awstypes.Status](attrOptionalComputed),
 			},
 		},
 	}
@@ -1119,8 +1130,10 @@ func expandColumnTag(tfMap map[string]interface{}) *awstypes.ColumnTag {
 
 	apiObject := &awstypes.ColumnTag{}
 
-	if v, ok := tfMap["column_description"].(map[string]interface{}); ok {
-		apiObject.ColumnDescription = expandColumnDescription(v)
+	if v, ok := tfMap["column_description"].([]interface{}); ok && len(v) > 0 {
+		if v, ok := v[0].(map[string]interface{}); ok {
+			apiObject.ColumnDescription = expandColumnDescription(v)
+		}
 	}
 	if v, ok := tfMap["column_geographic_role"].(string); ok {
 		apiObject.ColumnGeographicRole = awstypes.GeoSpatialDataRole(v)
@@ -1198,12 +1211,151 @@ func ExpandPhysicalTableMap(tfList []interface{}) map[string]awstypes.PhysicalTa
 			}
 		}
 
+		if apiObject == nil {
+			continue
+		}
+
 		apiObjects[tfMap["physical_table_map_id"].(string)] = apiObject
 	}
 
 	return apiObjects
 }
 
+// ValidatePhysicalTableMap checks that each physical table configures exactly one of
+// custom_sql, relational_table or s3_source, and that physical table IDs are unique.
+func ValidatePhysicalTableMap(tfList []interface{}) error {
+	ids := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id := tfMap["physical_table_map_id"].(string)
+
+		if _, ok := ids[id]; ok && id != "" {
+			return fmt.Errorf("physical_table_map (%s): duplicate physical_table_map_id", id)
+		}
+		ids[id] = struct{}{}
+
+		n := 0
+		for _, k := range []string{"custom_sql", "relational_table", "s3_source"} {
+			if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				n++
+			}
+		}
+
+		if n != 1 {
+			return fmt.Errorf("physical_table_map (%s): exactly one of custom_sql, relational_table or s3_source must be configured", id)
+		}
+	}
+
+	return nil
+}
+
+// ValidateLookbackWindowColumn checks that the incremental refresh lookback window column is a DATETIME column.
+// Columns whose type can't be determined from the physical and logical table maps are left to the create-time check.
+func ValidateLookbackWindowColumn(refreshProperties, physicalTableMap, logicalTableMap []interface{}) error {
+	columnName := lookbackWindowColumnName(refreshProperties)
+	if columnName == "" {
+		return nil
+	}
+
+	var columnTypes []string
+	for _, tfMapRaw := range physicalTableMap {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for k, columnsKey := range map[string]string{"custom_sql": "columns", "relational_table": "input_columns", "s3_source": "input_columns"} {
+			for _, tfMap := range tfMapsAt(tfMap[k]) {
+				for _, tfMap := range tfMapsAt(tfMap[columnsKey]) {
+					if tfMap[names.AttrName] == columnName {
+						v, _ := tfMap[names.AttrType].(string)
+						columnTypes = append(columnTypes, v)
+					}
+				}
+			}
+		}
+	}
+
+	for _, tfMapRaw := range logicalTableMap {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, tfMap := range tfMapsAt(tfMap["data_transforms"]) {
+			for _, tfMap := range tfMapsAt(tfMap["cast_column_type_operation"]) {
+				if tfMap["column_name"] == columnName {
+					v, _ := tfMap["new_column_type"].(string)
+					columnTypes = []string{v}
+				}
+			}
+			// Renamed and calculated columns have no type in the configuration.
+			for _, tfMap := range tfMapsAt(tfMap["rename_column_operation"]) {
+				if tfMap["new_column_name"] == columnName {
+					return nil
+				}
+			}
+			for _, tfMap := range tfMapsAt(tfMap["create_columns_operation"]) {
+				for _, tfMap := range tfMapsAt(tfMap["columns"]) {
+					if tfMap["column_name"] == columnName {
+						return nil
+					}
+				}
+			}
+		}
+	}
+
+	for _, v := range columnTypes {
+		if v != "" && v != string(awstypes.ColumnDataTypeDatetime) {
+			return fmt.Errorf("refresh_properties lookback_window column_name (%s) must be a %s column, got %s", columnName, awstypes.ColumnDataTypeDatetime, v)
+		}
+	}
+
+	return nil
+}
+
+func lookbackWindowColumnName(refreshProperties []interface{}) string {
+	for _, tfMap := range tfMapsAt(refreshProperties) {
+		for _, tfMap := range tfMapsAt(tfMap["refresh_configuration"]) {
+			for _, tfMap := range tfMapsAt(tfMap["incremental_refresh"]) {
+				for _, tfMap := range tfMapsAt(tfMap["lookback_window"]) {
+					if v, ok := tfMap["column_name"].(string); ok {
+						return v
+					}
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// tfMapsAt returns the nested blocks of a TypeList or TypeSet value.
+func tfMapsAt(v interface{}) []map[string]interface{} {
+	var tfList []interface{}
+
+	switch v := v.(type) {
+	case []interface{}:
+		tfList = v
+	case *schema.Set:
+		tfList = v.List()
+	}
+
+	var tfMaps []map[string]interface{}
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			tfMaps = append(tfMaps, tfMap)
+		}
+	}
+
+	return tfMaps
+}
+
 func expandCustomSQL(tfMap map[string]interface{}) *awstypes.CustomSql {
 	if tfMap == nil {
 		return nil
@@ -1304,8 +1456,8 @@ func expandS3Source(tfMap map[string]interface{}) *awstypes.S3Source {
 	if v, ok := tfMap["input_columns"].([]interface{}); ok {
 		apiObject.InputColumns = expandInputColumns(v)
 	}
-	if v, ok := tfMap["upload_settings"].(map[string]interface{}); ok {
-		apiObject.UploadSettings = expandUploadSettings(v)
+	if v, ok := tfMap["upload_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UploadSettings = expandUploadSettings(v[0].(map[string]interface{}))
 	}
 	if v, ok := tfMap["data_source_arn"].(string); ok {
 		apiObject.DataSourceArn = aws.String(v)
@@ -1358,13 +1510,13 @@ func ExpandRowLevelPermissionDataSet(tfList []interface{}) *awstypes.RowLevelPer
 	if v, ok := tfMap["permission_policy"].(string); ok {
 		apiObject.PermissionPolicy = awstypes.RowLevelPermissionPolicy(v)
 	}
-	if v, ok := tfMap["format_version"].(string); ok {
+	if v, ok := tfMap["format_version"].(string); ok && v != "" {
 		apiObject.FormatVersion = awstypes.RowLevelPermissionFormatVersion(v)
 	}
-	if v, ok := tfMap[names.AttrNamespace].(string); ok {
+	if v, ok := tfMap[names.AttrNamespace].(string); ok && v != "" {
 		apiObject.Namespace = aws.String(v)
 	}
-	if v, ok := tfMap[names.AttrStatus].(string); ok {
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
 		apiObject.Status = awstypes.Status(v)
 	}
 
@@ -1627,7 +1779,8 @@ func FlattenFieldFolders(apiObjects map[string]awstypes.FieldFolder) []interface
 
 	var tfList []interface{}
 
-	for k, apiObject := range apiObjects {
+	for _, k := range slices.Sorted(maps.Keys(apiObjects)) {
+		apiObject := apiObjects[k]
 		tfMap := map[string]interface{}{
 			"field_folders_id": k,
 		}
@@ -1652,7 +1805,9 @@ func FlattenLogicalTableMap(apiObjects map[string]awstypes.LogicalTable) []inter
 
 	var tfList []interface{}
 
-	for k, apiObject := range apiObjects {
+	// Map iteration order is random, so flatten in ID order for deterministic output.
+	for _, k := range slices.Sorted(maps.Keys(apiObjects)) {
+		apiObject := apiObjects[k]
 		tfMap := map[string]interface{}{
 			"logical_table_map_id": k,
 		}
@@ -1944,7 +2099,8 @@ func flattenJoinKeyProperties(apiObject *awstypes.JoinKeyProperties) map[string]
 func FlattenPhysicalTableMap(apiObjects map[string]awstypes.PhysicalTable) []interface{} {
 	var tfList []interface{}
 
-	for k, apiObject := range apiObjects {
+	for _, k := range slices.Sorted(maps.Keys(apiObjects)) {
+		apiObject := apiObjects[k]
 		tfMap := map[string]interface{}{
 			"physical_table_map_id": k,
 		}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateLookbackWindowColumn(t *testing.T) {
+	t.Parallel()
+
+	refreshProperties := []interface{}{map[string]interface{}{
+		"refresh_configuration": []interface{}{map[string]interface{}{
+			"incremental_refresh": []interface{}{map[string]interface{}{
+				"lookback_window": []interface{}{map[string]interface{}{
+					"column_name":  "updated_at",
+					names.AttrSize: 1,
+					"size_unit":    "DAY",
+				}},
+			}},
+		}},
+	}}
+	physicalTableMap := func(columnType string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"physical_table_map_id": "example",
+			"relational_table": []interface{}{map[string]interface{}{
+				"input_columns": []interface{}{map[string]interface{}{
+					names.AttrName: "updated_at",
+					names.AttrType: columnType,
+				}},
+			}},
+		}}
+	}
+	logicalTableMap := func(transform map[string]interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"data_transforms": []interface{}{transform},
+		}}
+	}
+
+	testCases := []struct {
+		name              string
+		refreshProperties []interface{}
+		physicalTableMap  []interface{}
+		logicalTableMap   []interface{}
+		expectedError     string
+	}{
+		{
+			name:             "no refresh properties",
+			physicalTableMap: physicalTableMap("STRING"),
+		},
+		{
+			name:              "datetime",
+			refreshProperties: refreshProperties,
+			physicalTableMap:  physicalTableMap("DATETIME"),
+		},
+		{
+			name:              "string",
+			refreshProperties: refreshProperties,
+			physicalTableMap:  physicalTableMap("STRING"),
+			expectedError:     "refresh_properties lookback_window column_name (updated_at) must be a DATETIME column, got STRING",
+		},
+		{
+			name:              "cast to datetime",
+			refreshProperties: refreshProperties,
+			physicalTableMap:  physicalTableMap("STRING"),
+			logicalTableMap: logicalTableMap(map[string]interface{}{
+				"cast_column_type_operation": []interface{}{map[string]interface{}{
+					"column_name":     "updated_at",
+					"new_column_type": "DATETIME",
+				}},
+			}),
+		},
+		{
+			name:              "cast from datetime",
+			refreshProperties: refreshProperties,
+			physicalTableMap:  physicalTableMap("DATETIME"),
+			logicalTableMap: logicalTableMap(map[string]interface{}{
+				"cast_column_type_operation": []interface{}{map[string]interface{}{
+					"column_name":     "updated_at",
+					"new_column_type": "INTEGER",
+				}},
+			}),
+			expectedError: "refresh_properties lookback_window column_name (updated_at) must be a DATETIME column, got INTEGER",
+		},
+		{
+			name:              "calculated column",
+			refreshProperties: refreshProperties,
+			physicalTableMap:  physicalTableMap("STRING"),
+			logicalTableMap: logicalTableMap(map[string]interface{}{
+				"create_columns_operation": []interface{}{map[string]interface{}{
+					"columns": []interface{}{map[string]interface{}{
+						"column_name": "updated_at",
+					}},
+				}},
+			}),
+		},
+		{
+			name:              "column not in configuration",
+			refreshProperties: refreshProperties,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateLookbackWindowColumn(testCase.refreshProperties, testCase.physicalTableMap, testCase.logicalTableMap)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTagColumnOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList    []interface{}
+		expected  *awstypes.TagColumnOperation
+		flattened []interface{}
+	}{
+		"geographic role": {
+			tfList: []interface{}{map[string]interface{}{
+				"column_name": "Column1",
+				names.AttrTags: []interface{}{map[string]interface{}{
+					"column_description":     []interface{}{},
+					"column_geographic_role": string(awstypes.GeoSpatialDataRoleCountry),
+				}},
+			}},
+			expected: &awstypes.TagColumnOperation{
+				ColumnName: aws.String("Column1"),
+				Tags: []awstypes.ColumnTag{
+					{ColumnGeographicRole: awstypes.GeoSpatialDataRoleCountry},
+				},
+			},
+			flattened: []interface{}{map[string]interface{}{
+				"column_name": "Column1",
+				names.AttrTags: []interface{}{map[string]interface{}{
+					"column_geographic_role": awstypes.GeoSpatialDataRoleCountry,
+				}},
+			}},
+		},
+		"description": {
+			tfList: []interface{}{map[string]interface{}{
+				"column_name": "Column1",
+				names.AttrTags: []interface{}{map[string]interface{}{
+					"column_description": []interface{}{map[string]interface{}{
+						"text": "Country of sale",
+					}},
+					"column_geographic_role": "",
+				}},
+			}},
+			expected: &awstypes.TagColumnOperation{
+				ColumnName: aws.String("Column1"),
+				Tags: []awstypes.ColumnTag{
+					{ColumnDescription: &awstypes.ColumnDescription{Text: aws.String("Country of sale")}},
+				},
+			},
+			flattened: []interface{}{map[string]interface{}{
+				"column_name": "Column1",
+				names.AttrTags: []interface{}{map[string]interface{}{
+					"column_description": []interface{}{map[string]interface{}{
+						"text": "Country of sale",
+					}},
+					"column_geographic_role": awstypes.GeoSpatialDataRole(""),
+				}},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := expandTagColumnOperation(testCase.tfList)
+
+			if diff := cmp.Diff(apiObject, testCase.expected, cmpopts.IgnoreUnexported(awstypes.TagColumnOperation{}, awstypes.ColumnTag{}, awstypes.ColumnDescription{})); diff != "" {
+				t.Errorf("unexpected expand diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(flattenTagColumnOperation(apiObject), testCase.flattened); diff != "" {
+				t.Errorf("unexpected flatten diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenTableMapsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	logicalTables := make(map[string]awstypes.LogicalTable)
+	physicalTables := make(map[string]awstypes.PhysicalTable)
+	var expectedIDs []string
+	for i := range 20 {
+		id := fmt.Sprintf("table%02d", i)
+		expectedIDs = append(expectedIDs, id)
+
+		logicalTables[id] = awstypes.LogicalTable{
+			Alias: aws.String(id),
+			Source: &awstypes.LogicalTableSource{
+				PhysicalTableId: aws.String(id),
+			},
+		}
+		physicalTables[id] = &awstypes.PhysicalTableMemberCustomSql{
+			Value: awstypes.CustomSql{
+				DataSourceArn: aws.String("arn:aws:quicksight:us-west-2:123456789012:datasource/example"), //lintignore:AWSAT003,AWSAT005
+				Name:          aws.String(id),
+				SqlQuery:      aws.String("SELECT 1"),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		flatten func() []interface{}
+		idKey   string
+	}{
+		"logical table map": {
+			flatten: func() []interface{} { return FlattenLogicalTableMap(logicalTables) },
+			idKey:   "logical_table_map_id",
+		},
+		"physical table map": {
+			flatten: func() []interface{} { return FlattenPhysicalTableMap(physicalTables) },
+			idKey:   "physical_table_map_id",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first := testCase.flatten()
+			second := testCase.flatten()
+
+			if diff := cmp.Diff(first, second); diff != "" {
+				t.Errorf("repeated flatten differs (+second, -first): %s", diff)
+			}
+
+			var ids []string
+			for _, tfMapRaw := range first {
+				ids = append(ids, tfMapRaw.(map[string]interface{})[testCase.idKey].(string))
+			}
+
+			if diff := cmp.Diff(ids, expectedIDs); diff != "" {
+				t.Errorf("unexpected ID order (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
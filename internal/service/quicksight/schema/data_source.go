@@ -4,10 +4,17 @@
 package schema
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -22,7 +29,7 @@ func DataSourceCredentialsSchema() *schema.Schema {
 				"copy_source_arn": {
 					Type:          schema.TypeString,
 					Optional:      true,
-					ValidateFunc:  verify.ValidARN,
+					ValidateFunc:  validDataSourceARN,
 					ConflictsWith: []string{"credentials.0.credential_pair", "credentials.0.secret_arn"},
 				},
 				"credential_pair": {
@@ -31,6 +38,12 @@ func DataSourceCredentialsSchema() *schema.Schema {
 					MaxItems: 1,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"alternate_data_source_parameters": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 50,
+								Elem:     alternateDataSourceParametersResource(),
+							},
 							names.AttrPassword: {
 								Type:     schema.TypeString,
 								Required: true,
@@ -63,6 +76,8 @@ func DataSourceCredentialsSchema() *schema.Schema {
 	}
 }
 
+var validDataSourceARN = validResourceARN("datasource", "data source")
+
 func DataSourceParametersSchema() *schema.Schema {
 	exactlyOneOf := []string{
 		"parameters.0.amazon_elasticsearch",
@@ -70,6 +85,7 @@ func DataSourceParametersSchema() *schema.Schema {
 		"parameters.0.aurora",
 		"parameters.0.aurora_postgresql",
 		"parameters.0.aws_iot_analytics",
+		"parameters.0.big_query",
 		"parameters.0.databricks",
 		"parameters.0.jira",
 		"parameters.0.maria_db",
@@ -84,6 +100,7 @@ func DataSourceParametersSchema() *schema.Schema {
 		"parameters.0.snowflake",
 		"parameters.0.spark",
 		"parameters.0.sql_server",
+		"parameters.0.starburst",
 		"parameters.0.teradata",
 		"parameters.0.twitter",
 	}
@@ -119,6 +136,7 @@ func DataSourceParametersSchema() *schema.Schema {
 							"work_group": {
 								Type:         schema.TypeString,
 								Optional:     true,
+								Computed:     true,
 								ValidateFunc: validation.NoZeroValues,
 							},
 						},
@@ -190,6 +208,25 @@ func DataSourceParametersSchema() *schema.Schema {
 					},
 					ExactlyOneOf: exactlyOneOf,
 				},
+				"big_query": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"data_set_region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"project_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+					ExactlyOneOf: exactlyOneOf,
+				},
 				"databricks": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -392,12 +429,47 @@ func DataSourceParametersSchema() *schema.Schema {
 								ValidateFunc: validation.NoZeroValues,
 							},
 							"host": {
-								Type:     schema.TypeString,
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateFunc:     validation.NoZeroValues,
+								DiffSuppressFunc: suppressRedshiftClusterEndpoint,
+							},
+							"iam_parameters": {
+								Type:     schema.TypeList,
 								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"auto_create_database_user": {
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  false,
+										},
+										"database_groups": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MinItems: 1,
+											MaxItems: 50,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"database_user": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 64),
+										},
+										names.AttrRoleARN: {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
 							},
 							names.AttrPort: {
-								Type:     schema.TypeInt,
-								Optional: true,
+								Type:             schema.TypeInt,
+								Optional:         true,
+								ValidateFunc:     validation.IntAtLeast(1),
+								DiffSuppressFunc: suppressRedshiftClusterEndpoint,
 							},
 						},
 					},
@@ -517,6 +589,37 @@ func DataSourceParametersSchema() *schema.Schema {
 					},
 					ExactlyOneOf: exactlyOneOf,
 				},
+				"starburst": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"catalog": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							"host": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							names.AttrPort: {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"product_type": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.StarburstProductType](),
+							},
+						},
+					},
+					ExactlyOneOf: exactlyOneOf,
+				},
 				"teradata": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -567,6 +670,112 @@ func DataSourceParametersSchema() *schema.Schema {
 	}
 }
 
+// alternateDataSourceParametersResource returns the data source parameter blocks for use in a list.
+// ExactlyOneOf can only address the primary parameters, so alternate parameters are checked by ValidateAlternateDataSourceParameters
+// and ValidateRedshiftParameters. The nested blocks mustn't use absolute paths in ExactlyOneOf, ConflictsWith or RequiredWith.
+func alternateDataSourceParametersResource() *schema.Resource {
+	r := DataSourceParametersSchema().Elem.(*schema.Resource)
+
+	for _, v := range r.Schema {
+		v.ExactlyOneOf = nil
+	}
+
+	return r
+}
+
+// ValidateDataSourceRedshiftParameters checks the primary and each alternate redshift parameters in the raw configuration
+// of a data source with ValidateRedshiftParameters. Each block is checked on its own, so a cluster ID can fail over to
+// a host and port.
+func ValidateDataSourceRedshiftParameters(config cty.Value) error {
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	if v, ok := firstConfiguredBlock(config.GetAttr(names.AttrParameters)); ok {
+		if err := ValidateRedshiftParameters("parameters.0", v); err != nil {
+			return err
+		}
+	}
+
+	credentials, ok := firstConfiguredBlock(config.GetAttr("credentials"))
+	if !ok {
+		return nil
+	}
+
+	credentialPair, ok := firstConfiguredBlock(credentials.GetAttr("credential_pair"))
+	if !ok {
+		return nil
+	}
+
+	alternates := credentialPair.GetAttr("alternate_data_source_parameters")
+	if !alternates.IsKnown() || alternates.IsNull() {
+		return nil
+	}
+
+	for i, v := range alternates.AsValueSlice() {
+		if err := ValidateRedshiftParameters(fmt.Sprintf("credentials.0.credential_pair.0.alternate_data_source_parameters.%d", i), v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateRedshiftParameters returns an error if the redshift block of the configured data source parameters at path
+// doesn't set exactly one of cluster_id or host, or sets only one of host and port. The block is also used in alternate
+// data source parameters, so the raw configuration is checked instead of using ExactlyOneOf and RequiredWith paths.
+func ValidateRedshiftParameters(path string, parameters cty.Value) error {
+	if !parameters.IsKnown() || parameters.IsNull() {
+		return nil
+	}
+
+	redshift, ok := firstConfiguredBlock(parameters.GetAttr("redshift"))
+	if !ok {
+		return nil
+	}
+
+	clusterID := isConfiguredValue(redshift.GetAttr("cluster_id"))
+	host := isConfiguredValue(redshift.GetAttr("host"))
+	port := isConfiguredValue(redshift.GetAttr(names.AttrPort))
+
+	switch {
+	case clusterID && host:
+		return fmt.Errorf("%s.redshift.0: only one of cluster_id or host can be specified", path)
+	case !clusterID && !host:
+		return fmt.Errorf("%s.redshift.0: one of cluster_id or host must be specified", path)
+	case host != port:
+		return fmt.Errorf("%s.redshift.0: host and port must be specified together", path)
+	}
+
+	return nil
+}
+
+// firstConfiguredBlock returns the first element of a known, non-empty list block.
+func firstConfiguredBlock(v cty.Value) (cty.Value, bool) {
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return cty.NilVal, false
+	}
+
+	return v.Index(cty.NumberIntVal(0)), true
+}
+
+// isConfiguredValue returns whether an attribute is set in the configuration. Unknown values will be set once known.
+func isConfiguredValue(v cty.Value) bool {
+	return !v.IsKnown() || !v.IsNull()
+}
+
+// suppressRedshiftClusterEndpoint suppresses the diff of a Redshift host or port that is omitted from the configuration
+// but that QuickSight filled in from the configured cluster_id. Without a cluster ID, removing the value is a change.
+func suppressRedshiftClusterEndpoint(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || old == "0" || (new != "" && new != "0") {
+		return false
+	}
+
+	clusterID, ok := d.Get(k[:strings.LastIndex(k, ".")+1] + "cluster_id").(string)
+
+	return ok && clusterID != ""
+}
+
 func SSLPropertiesSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -644,9 +853,73 @@ func expandCredentialPair(tfList []interface{}) *awstypes.CredentialPair {
 		apiObject.Password = aws.String(v)
 	}
 
+	if v, ok := tfMap["alternate_data_source_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AlternateDataSourceParameters = expandAlternateDataSourceParameters(v)
+	}
+
 	return apiObject
 }
 
+func expandAlternateDataSourceParameters(tfList []interface{}) []awstypes.DataSourceParameters {
+	var apiObjects []awstypes.DataSourceParameters
+
+	for _, tfMapRaw := range tfList {
+		apiObject := ExpandDataSourceParameters([]interface{}{tfMapRaw})
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// ValidateAlternateDataSourceParameters returns an error if any alternate data source parameters don't
+// configure exactly one parameter block of the same type as the primary parameters.
+func ValidateAlternateDataSourceParameters(parameters, alternates []interface{}) error {
+	if len(alternates) == 0 {
+		return nil
+	}
+
+	var primary []string
+	if len(parameters) > 0 {
+		if tfMap, ok := parameters[0].(map[string]interface{}); ok {
+			primary = dataSourceParametersTypes(tfMap)
+		}
+	}
+
+	for i, tfMapRaw := range alternates {
+		tfMap, _ := tfMapRaw.(map[string]interface{})
+		types := dataSourceParametersTypes(tfMap)
+
+		if len(types) != 1 {
+			return fmt.Errorf("alternate_data_source_parameters.%d: exactly one parameter block must be configured, got %d", i, len(types))
+		}
+
+		if len(primary) == 1 && types[0] != primary[0] {
+			return fmt.Errorf("alternate_data_source_parameters.%d: %s parameters don't match the %s data source parameters", i, types[0], primary[0])
+		}
+	}
+
+	return nil
+}
+
+// dataSourceParametersTypes returns the sorted names of the configured parameter blocks.
+func dataSourceParametersTypes(tfMap map[string]interface{}) []string {
+	var types []string
+
+	for k := range alternateDataSourceParametersResource().Schema {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			types = append(types, k)
+		}
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
 func ExpandDataSourceParameters(tfList []interface{}) awstypes.DataSourceParameters {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -731,6 +1004,21 @@ func ExpandDataSourceParameters(tfList []interface{}) awstypes.DataSourceParamet
 		}
 	}
 
+	if v, ok := tfMap["big_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			ps := &awstypes.DataSourceParametersMemberBigQueryParameters{}
+
+			if v, ok := tfMap["data_set_region"].(string); ok && v != "" {
+				ps.Value.DataSetRegion = aws.String(v)
+			}
+			if v, ok := tfMap["project_id"].(string); ok && v != "" {
+				ps.Value.ProjectId = aws.String(v)
+			}
+
+			apiObject = ps
+		}
+	}
+
 	if v := tfMap["databricks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		if tfMap, ok := v[0].(map[string]interface{}); ok {
 			ps := &awstypes.DataSourceParametersMemberDatabricksParameters{}
@@ -879,6 +1167,9 @@ func ExpandDataSourceParameters(tfList []interface{}) awstypes.DataSourceParamet
 			if v, ok := tfMap["host"].(string); ok && v != "" {
 				ps.Value.Host = aws.String(v)
 			}
+			if v, ok := tfMap["iam_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				ps.Value.IAMParameters = expandRedshiftIAMParameters(v[0].(map[string]interface{}))
+			}
 			if v, ok := tfMap[names.AttrPort].(int); ok {
 				ps.Value.Port = int32(v)
 			}
@@ -973,6 +1264,27 @@ func ExpandDataSourceParameters(tfList []interface{}) awstypes.DataSourceParamet
 		}
 	}
 
+	if v, ok := tfMap["starburst"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			ps := &awstypes.DataSourceParametersMemberStarburstParameters{}
+
+			if v, ok := tfMap["catalog"].(string); ok && v != "" {
+				ps.Value.Catalog = aws.String(v)
+			}
+			if v, ok := tfMap["host"].(string); ok && v != "" {
+				ps.Value.Host = aws.String(v)
+			}
+			if v, ok := tfMap[names.AttrPort].(int); ok {
+				ps.Value.Port = aws.Int32(int32(v))
+			}
+			if v, ok := tfMap["product_type"].(string); ok && v != "" {
+				ps.Value.ProductType = awstypes.StarburstProductType(v)
+			}
+
+			apiObject = ps
+		}
+	}
+
 	if v := tfMap["teradata"].([]interface{}); ok && len(v) > 0 && v != nil {
 		if tfMap, ok := v[0].(map[string]interface{}); ok {
 			ps := &awstypes.DataSourceParametersMemberTeradataParameters{}
@@ -1051,6 +1363,13 @@ func FlattenDataSourceParameters(apiObject awstypes.DataSourceParameters) []inte
 				"data_set_name": aws.ToString(v.Value.DataSetName),
 			},
 		}
+	case *awstypes.DataSourceParametersMemberBigQueryParameters:
+		tfMap["big_query"] = []interface{}{
+			map[string]interface{}{
+				"data_set_region": aws.ToString(v.Value.DataSetRegion),
+				"project_id":      aws.ToString(v.Value.ProjectId),
+			},
+		}
 	case *awstypes.DataSourceParametersMemberDatabricksParameters:
 		tfMap["databricks"] = []interface{}{
 			map[string]interface{}{
@@ -1118,6 +1437,7 @@ func FlattenDataSourceParameters(apiObject awstypes.DataSourceParameters) []inte
 				"cluster_id":       aws.ToString(v.Value.ClusterId),
 				names.AttrDatabase: aws.ToString(v.Value.Database),
 				"host":             aws.ToString(v.Value.Host),
+				"iam_parameters":   flattenRedshiftIAMParameters(v.Value.IAMParameters),
 				names.AttrPort:     v.Value.Port,
 			},
 		}
@@ -1147,7 +1467,7 @@ func FlattenDataSourceParameters(apiObject awstypes.DataSourceParameters) []inte
 			},
 		}
 	case *awstypes.DataSourceParametersMemberSparkParameters:
-		tfMap["snowflake"] = []interface{}{
+		tfMap["spark"] = []interface{}{
 			map[string]interface{}{
 				"host":         aws.ToString(v.Value.Host),
 				names.AttrPort: aws.ToInt32(v.Value.Port),
@@ -1161,6 +1481,15 @@ func FlattenDataSourceParameters(apiObject awstypes.DataSourceParameters) []inte
 				names.AttrPort:     v.Value.Port,
 			},
 		}
+	case *awstypes.DataSourceParametersMemberStarburstParameters:
+		tfMap["starburst"] = []interface{}{
+			map[string]interface{}{
+				"catalog":      aws.ToString(v.Value.Catalog),
+				"host":         aws.ToString(v.Value.Host),
+				names.AttrPort: aws.ToInt32(v.Value.Port),
+				"product_type": string(v.Value.ProductType),
+			},
+		}
 	case *awstypes.DataSourceParametersMemberTeradataParameters:
 		tfMap["teradata"] = []interface{}{
 			map[string]interface{}{
@@ -1170,7 +1499,7 @@ func FlattenDataSourceParameters(apiObject awstypes.DataSourceParameters) []inte
 			},
 		}
 	case *awstypes.DataSourceParametersMemberTwitterParameters:
-		tfMap["teradata"] = []interface{}{
+		tfMap["twitter"] = []interface{}{
 			map[string]interface{}{
 				"max_rows": aws.ToInt32(v.Value.MaxRows),
 				"query":    aws.ToString(v.Value.Query),
@@ -1183,6 +1512,44 @@ func FlattenDataSourceParameters(apiObject awstypes.DataSourceParameters) []inte
 	return []interface{}{tfMap}
 }
 
+func expandRedshiftIAMParameters(tfMap map[string]interface{}) *awstypes.RedshiftIAMParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.RedshiftIAMParameters{}
+
+	if v, ok := tfMap["auto_create_database_user"].(bool); ok {
+		apiObject.AutoCreateDatabaseUser = v
+	}
+	if v, ok := tfMap["database_groups"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DatabaseGroups = flex.ExpandStringValueList(v)
+	}
+	if v, ok := tfMap["database_user"].(string); ok && v != "" {
+		apiObject.DatabaseUser = aws.String(v)
+	}
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRedshiftIAMParameters(apiObject *awstypes.RedshiftIAMParameters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"auto_create_database_user": apiObject.AutoCreateDatabaseUser,
+		"database_groups":           apiObject.DatabaseGroups,
+		"database_user":             aws.ToString(apiObject.DatabaseUser),
+		names.AttrRoleARN:           aws.ToString(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func ExpandSSLProperties(tfList []interface{}) *awstypes.SslProperties {
 	if len(tfList) == 0 {
 		return nil
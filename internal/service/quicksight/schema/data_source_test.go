@@ -0,0 +1,494 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidDataSourceARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "data source",
+			value: "arn:aws:quicksight:us-west-2:123456789012:datasource/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:  "cross-account",
+			value: "arn:aws:quicksight:us-west-2:210987654321:datasource/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:        "not an ARN",
+			value:       "datasource/example",
+			expectError: true,
+		},
+		{
+			name:        "other service",
+			value:       "arn:aws:secretsmanager:us-west-2:123456789012:secret:example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name:        "data set",
+			value:       "arn:aws:quicksight:us-west-2:123456789012:dataset/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name:        "no data source ID",
+			value:       "arn:aws:quicksight:us-west-2:123456789012:datasource/", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validDataSourceARN(testCase.value, names.AttrARN)
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Errorf("errors = %v, expectError = %t", errs, want)
+			}
+		})
+	}
+}
+
+func TestSuppressRedshiftClusterEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		clusterID string
+		old       string
+		new       string
+		expected  bool
+	}{
+		{
+			name: "create",
+			old:  "",
+			new:  "",
+		},
+		{
+			name: "configured",
+			old:  "",
+			new:  "5439",
+		},
+		{
+			name:      "omitted with cluster",
+			clusterID: "example",
+			old:       "5439",
+			new:       "0",
+			expected:  true,
+		},
+		{
+			name: "explicit value removed",
+			old:  "5439",
+			new:  "0",
+		},
+		{
+			name:      "changed",
+			clusterID: "example",
+			old:       "5439",
+			new:       "5440",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			redshift := map[string]interface{}{
+				names.AttrDatabase: "dev",
+			}
+			if testCase.clusterID != "" {
+				redshift["cluster_id"] = testCase.clusterID
+			}
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{names.AttrParameters: DataSourceParametersSchema()}, map[string]interface{}{
+				names.AttrParameters: []interface{}{map[string]interface{}{
+					"redshift": []interface{}{redshift},
+				}},
+			})
+
+			if got, want := suppressRedshiftClusterEndpoint("parameters.0.redshift.0.port", testCase.old, testCase.new, d), testCase.expected; got != want {
+				t.Errorf("suppressRedshiftClusterEndpoint(%q, %q) = %t, want %t", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateRedshiftParameters(t *testing.T) {
+	t.Parallel()
+
+	redshift := func(clusterID, host, port cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"redshift": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"cluster_id":   clusterID,
+				"host":         host,
+				names.AttrPort: port,
+			})}),
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		parameters    cty.Value
+		expectedError string
+	}{
+		{
+			name:       "cluster",
+			parameters: redshift(cty.StringVal("example"), cty.NullVal(cty.String), cty.NullVal(cty.Number)),
+		},
+		{
+			name:       "host and port",
+			parameters: redshift(cty.NullVal(cty.String), cty.StringVal("example.com"), cty.NumberIntVal(5439)),
+		},
+		{
+			name:       "unknown cluster",
+			parameters: redshift(cty.UnknownVal(cty.String), cty.NullVal(cty.String), cty.NullVal(cty.Number)),
+		},
+		{
+			name: "not redshift",
+			parameters: cty.ObjectVal(map[string]cty.Value{
+				"redshift": cty.ListValEmpty(cty.EmptyObject),
+			}),
+		},
+		{
+			name:          "cluster and host",
+			parameters:    redshift(cty.StringVal("example"), cty.StringVal("example.com"), cty.NumberIntVal(5439)),
+			expectedError: "parameters.0.redshift.0: only one of cluster_id or host can be specified",
+		},
+		{
+			name:          "neither",
+			parameters:    redshift(cty.NullVal(cty.String), cty.NullVal(cty.String), cty.NullVal(cty.Number)),
+			expectedError: "parameters.0.redshift.0: one of cluster_id or host must be specified",
+		},
+		{
+			name:          "host without port",
+			parameters:    redshift(cty.NullVal(cty.String), cty.StringVal("example.com"), cty.NullVal(cty.Number)),
+			expectedError: "parameters.0.redshift.0: host and port must be specified together",
+		},
+		{
+			name:          "cluster with port",
+			parameters:    redshift(cty.StringVal("example"), cty.NullVal(cty.String), cty.NumberIntVal(5439)),
+			expectedError: "parameters.0.redshift.0: host and port must be specified together",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRedshiftParameters("parameters.0", testCase.parameters)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateDataSourceRedshiftParameters(t *testing.T) {
+	t.Parallel()
+
+	redshift := func(clusterID, host, port cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"redshift": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"cluster_id":   clusterID,
+				"host":         host,
+				names.AttrPort: port,
+			})}),
+		})
+	}
+	config := func(primary cty.Value, alternates ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			names.AttrParameters: cty.ListVal([]cty.Value{primary}),
+			"credentials": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"credential_pair": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"alternate_data_source_parameters": cty.ListVal(alternates),
+				})}),
+			})}),
+		})
+	}
+	cluster := redshift(cty.StringVal("example"), cty.NullVal(cty.String), cty.NullVal(cty.Number))
+	endpoint := redshift(cty.NullVal(cty.String), cty.StringVal("example.com"), cty.NumberIntVal(5439))
+
+	testCases := []struct {
+		name          string
+		config        cty.Value
+		expectedError string
+	}{
+		{
+			name:   "cluster with endpoint alternate",
+			config: config(cluster, endpoint),
+		},
+		{
+			name:   "endpoint with cluster alternate",
+			config: config(endpoint, cluster),
+		},
+		{
+			name:          "invalid alternate",
+			config:        config(cluster, endpoint, redshift(cty.NullVal(cty.String), cty.StringVal("example.com"), cty.NullVal(cty.Number))),
+			expectedError: "credentials.0.credential_pair.0.alternate_data_source_parameters.1.redshift.0: host and port must be specified together",
+		},
+		{
+			name:          "invalid primary",
+			config:        config(redshift(cty.StringVal("example"), cty.StringVal("example.com"), cty.NumberIntVal(5439)), cluster),
+			expectedError: "parameters.0.redshift.0: only one of cluster_id or host can be specified",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateDataSourceRedshiftParameters(testCase.config)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		apiObject awstypes.DataSourceParameters
+		expected  []interface{}
+	}{
+		{
+			name: "big query",
+			apiObject: &awstypes.DataSourceParametersMemberBigQueryParameters{
+				Value: awstypes.BigQueryParameters{
+					DataSetRegion: aws.String("us-east1"),
+					ProjectId:     aws.String("example"),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"big_query": []interface{}{map[string]interface{}{
+					"data_set_region": "us-east1",
+					"project_id":      "example",
+				}},
+			}},
+		},
+		{
+			name: "redshift iam",
+			apiObject: &awstypes.DataSourceParametersMemberRedshiftParameters{
+				Value: awstypes.RedshiftParameters{
+					ClusterId: aws.String("example"),
+					Database:  aws.String("dev"),
+					IAMParameters: &awstypes.RedshiftIAMParameters{
+						AutoCreateDatabaseUser: true,
+						DatabaseGroups:         []string{"analysts"},
+						RoleArn:                aws.String("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+					},
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"redshift": []interface{}{map[string]interface{}{
+					"cluster_id":       "example",
+					names.AttrDatabase: "dev",
+					"host":             "",
+					"iam_parameters": []interface{}{map[string]interface{}{
+						"auto_create_database_user": true,
+						"database_groups":           []string{"analysts"},
+						"database_user":             "",
+						names.AttrRoleARN:           "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+					}},
+					names.AttrPort: int32(0),
+				}},
+			}},
+		},
+		{
+			name: "spark",
+			apiObject: &awstypes.DataSourceParametersMemberSparkParameters{
+				Value: awstypes.SparkParameters{
+					Host: aws.String("example.com"),
+					Port: aws.Int32(10000),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"spark": []interface{}{map[string]interface{}{
+					"host":         "example.com",
+					names.AttrPort: int32(10000),
+				}},
+			}},
+		},
+		{
+			name: "starburst",
+			apiObject: &awstypes.DataSourceParametersMemberStarburstParameters{
+				Value: awstypes.StarburstParameters{
+					Catalog:     aws.String("example"),
+					Host:        aws.String("example.galaxy.starburst.io"),
+					Port:        aws.Int32(443),
+					ProductType: awstypes.StarburstProductTypeGalaxy,
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"starburst": []interface{}{map[string]interface{}{
+					"catalog":      "example",
+					"host":         "example.galaxy.starburst.io",
+					names.AttrPort: int32(443),
+					"product_type": "GALAXY",
+				}},
+			}},
+		},
+		{
+			name: "twitter",
+			apiObject: &awstypes.DataSourceParametersMemberTwitterParameters{
+				Value: awstypes.TwitterParameters{
+					MaxRows: aws.Int32(100),
+					Query:   aws.String("example"),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"twitter": []interface{}{map[string]interface{}{
+					"max_rows": int32(100),
+					"query":    "example",
+				}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FlattenDataSourceParameters(testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateAlternateDataSourceParameters(t *testing.T) {
+	t.Parallel()
+
+	redshift := map[string]interface{}{
+		"redshift": []interface{}{map[string]interface{}{
+			"cluster_id":       "example",
+			names.AttrDatabase: "dev",
+		}},
+	}
+	athena := map[string]interface{}{
+		"athena": []interface{}{map[string]interface{}{
+			"work_group": "primary",
+		}},
+	}
+
+	testCases := []struct {
+		name          string
+		alternates    []interface{}
+		expectedError string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:       "same type",
+			alternates: []interface{}{redshift, redshift},
+		},
+		{
+			name:          "different type",
+			alternates:    []interface{}{redshift, athena},
+			expectedError: "alternate_data_source_parameters.1: athena parameters don't match the redshift data source parameters",
+		},
+		{
+			name:          "empty",
+			alternates:    []interface{}{map[string]interface{}{}},
+			expectedError: "alternate_data_source_parameters.0: exactly one parameter block must be configured, got 0",
+		},
+		{
+			name:          "multiple types",
+			alternates:    []interface{}{map[string]interface{}{"athena": athena["athena"], "redshift": redshift["redshift"]}},
+			expectedError: "alternate_data_source_parameters.0: exactly one parameter block must be configured, got 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateAlternateDataSourceParameters([]interface{}{redshift}, testCase.alternates)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandCredentialPairAlternateDataSourceParameters(t *testing.T) {
+	t.Parallel()
+
+	apiObject := expandCredentialPair([]interface{}{map[string]interface{}{
+		names.AttrPassword: "password",
+		names.AttrUsername: "username",
+		"alternate_data_source_parameters": []interface{}{map[string]interface{}{
+			"redshift": []interface{}{map[string]interface{}{
+				"cluster_id":       "example",
+				names.AttrDatabase: "dev",
+				"host":             "",
+				names.AttrPort:     0,
+			}},
+		}},
+	}})
+
+	if got, want := len(apiObject.AlternateDataSourceParameters), 1; got != want {
+		t.Fatalf("alternate data source parameters = %d, want %d", got, want)
+	}
+
+	v, ok := apiObject.AlternateDataSourceParameters[0].(*awstypes.DataSourceParametersMemberRedshiftParameters)
+	if !ok {
+		t.Fatalf("alternate data source parameters type = %T, want Redshift", apiObject.AlternateDataSourceParameters[0])
+	}
+	if got, want := aws.ToString(v.Value.ClusterId), "example"; got != want {
+		t.Errorf("cluster ID = %q, want %q", got, want)
+	}
+}
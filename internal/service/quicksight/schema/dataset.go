@@ -4,6 +4,9 @@
 package schema
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -107,3 +110,87 @@ func flattenDataSetIdentifierDeclarations(apiObject []awstypes.DataSetIdentifier
 
 	return tfList
 }
+
+// ValidateSheetDataSetIdentifiers checks that every data_set_identifier referenced from the
+// sheets of an analysis or dashboard definition is declared in data_set_identifiers_declarations.
+// Validation is skipped while any declared identifier is not yet known.
+func ValidateSheetDataSetIdentifiers(tfMap map[string]interface{}) error {
+	declared := make(map[string]struct{})
+
+	if v, ok := tfMap["data_set_identifiers_declarations"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			v, _ := tfMap[names.AttrIdentifier].(string)
+			if v == "" {
+				return nil
+			}
+
+			declared[v] = struct{}{}
+		}
+	}
+
+	v, ok := tfMap["sheets"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for i, tfMapRaw := range v {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		referenced := make(map[string]struct{})
+		collectDataSetIdentifiers(tfMap, referenced)
+
+		var undeclared []string
+		for id := range referenced {
+			if _, ok := declared[id]; !ok {
+				undeclared = append(undeclared, id)
+			}
+		}
+
+		if len(undeclared) == 0 {
+			continue
+		}
+
+		slices.Sort(undeclared)
+
+		sheet := fmt.Sprintf("sheets.%d", i)
+		if v, ok := tfMap["sheet_id"].(string); ok && v != "" {
+			sheet = v
+		}
+
+		return fmt.Errorf("sheet (%s) references data set identifiers not declared in data_set_identifiers_declarations: %s", sheet, strings.Join(undeclared, ", "))
+	}
+
+	return nil
+}
+
+func collectDataSetIdentifiers(v interface{}, identifiers map[string]struct{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, v := range v {
+			if k == "data_set_identifier" {
+				if v, ok := v.(string); ok && v != "" {
+					identifiers[v] = struct{}{}
+				}
+				continue
+			}
+
+			collectDataSetIdentifiers(v, identifiers)
+		}
+	case []interface{}:
+		for _, v := range v {
+			collectDataSetIdentifiers(v, identifiers)
+		}
+	case *schema.Set:
+		for _, v := range v.List() {
+			collectDataSetIdentifiers(v, identifiers)
+		}
+	}
+}
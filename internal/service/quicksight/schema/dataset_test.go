@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateSheetDataSetIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	declarations := func(identifiers ...string) []interface{} {
+		var tfList []interface{}
+		for _, v := range identifiers {
+			tfList = append(tfList, map[string]interface{}{
+				"data_set_arn":       "arn:aws:quicksight:us-west-2:123456789012:dataset/" + v, //lintignore:AWSAT003,AWSAT005
+				names.AttrIdentifier: v,
+			})
+		}
+		return tfList
+	}
+	sheet := func(sheetID string, identifiers ...string) map[string]interface{} {
+		var visuals []interface{}
+		for _, v := range identifiers {
+			visuals = append(visuals, map[string]interface{}{
+				"line_chart_visual": []interface{}{map[string]interface{}{
+					"chart_configuration": []interface{}{map[string]interface{}{
+						"field_wells": []interface{}{map[string]interface{}{
+							"column": []interface{}{map[string]interface{}{
+								"column_name":         "Column1",
+								"data_set_identifier": v,
+							}},
+						}},
+					}},
+				}},
+			})
+		}
+		return map[string]interface{}{
+			"sheet_id": sheetID,
+			"visuals":  visuals,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		tfMap         map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "all declared",
+			tfMap: map[string]interface{}{
+				"data_set_identifiers_declarations": declarations("1", "2"),
+				"sheets":                            []interface{}{sheet("Sheet1", "1"), sheet("Sheet2", "1", "2")},
+			},
+		},
+		{
+			name: "undeclared",
+			tfMap: map[string]interface{}{
+				"data_set_identifiers_declarations": declarations("1"),
+				"sheets":                            []interface{}{sheet("Sheet1", "1"), sheet("Sheet2", "3", "2", "1")},
+			},
+			expectedError: "sheet (Sheet2) references data set identifiers not declared in data_set_identifiers_declarations: 2, 3",
+		},
+		{
+			name: "unknown declaration",
+			tfMap: map[string]interface{}{
+				"data_set_identifiers_declarations": declarations(""),
+				"sheets":                            []interface{}{sheet("Sheet1", "1")},
+			},
+		},
+		{
+			name: "no sheets",
+			tfMap: map[string]interface{}{
+				"data_set_identifiers_declarations": declarations("1"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateSheetDataSetIdentifiers(testCase.tfMap)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
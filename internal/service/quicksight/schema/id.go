@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// ResourceIDMaxLen is the maximum length of analysis, dashboard, template and theme IDs.
+	ResourceIDMaxLen = 512
+	// FolderIDMaxLen is the maximum length of folder IDs.
+	FolderIDMaxLen = 2048
+)
+
+// ValidResourceID validates the ID of a QuickSight asset, which may only contain word characters and hyphens (`[\w\-]+`).
+func ValidResourceID(maxLen int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLen),
+		validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens, and underscores"),
+	)
+}
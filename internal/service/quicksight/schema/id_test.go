@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		maxLen      int
+		expectError bool
+	}{
+		{
+			name:        "empty",
+			value:       "",
+			maxLen:      ResourceIDMaxLen,
+			expectError: true,
+		},
+		{
+			name:   "alphanumeric",
+			value:  "Dashboard1",
+			maxLen: ResourceIDMaxLen,
+		},
+		{
+			name:   "hyphens and underscores",
+			value:  "tf-acc-test_1234",
+			maxLen: ResourceIDMaxLen,
+		},
+		{
+			name:   "uuid",
+			value:  "4d5f4bb4-5f3c-4e31-a8b9-0b1f8b3b6c8e",
+			maxLen: ResourceIDMaxLen,
+		},
+		{
+			name:        "space",
+			value:       "my dashboard",
+			maxLen:      ResourceIDMaxLen,
+			expectError: true,
+		},
+		{
+			name:        "period",
+			value:       "dashboard.1",
+			maxLen:      ResourceIDMaxLen,
+			expectError: true,
+		},
+		{
+			name:        "slash",
+			value:       "dashboard/1",
+			maxLen:      ResourceIDMaxLen,
+			expectError: true,
+		},
+		{
+			name:        "non-ASCII",
+			value:       "tableau_de_bord_é",
+			maxLen:      ResourceIDMaxLen,
+			expectError: true,
+		},
+		{
+			name:   "maximum length",
+			value:  strings.Repeat("a", ResourceIDMaxLen),
+			maxLen: ResourceIDMaxLen,
+		},
+		{
+			name:        "over maximum length",
+			value:       strings.Repeat("a", ResourceIDMaxLen+1),
+			maxLen:      ResourceIDMaxLen,
+			expectError: true,
+		},
+		{
+			name:   "folder maximum length",
+			value:  strings.Repeat("a", FolderIDMaxLen),
+			maxLen: FolderIDMaxLen,
+		},
+		{
+			name:        "folder over maximum length",
+			value:       strings.Repeat("a", FolderIDMaxLen+1),
+			maxLen:      FolderIDMaxLen,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := ValidResourceID(testCase.maxLen)(testCase.value, "dashboard_id")
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Errorf("errors = %v, expectError = %t", errs, want)
+			}
+		})
+	}
+}
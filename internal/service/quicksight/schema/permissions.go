@@ -4,6 +4,9 @@
 package schema
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -93,64 +96,100 @@ func FlattenPermissions(apiObjects []awstypes.ResourcePermission) []interface{}
 	return tfList
 }
 
+// ValidateNoLinkSharingPrincipals returns an error naming the first permission whose principal is
+// an anonymous or wildcard principal, as used for dashboard link sharing. Analyses only accept
+// user, group and namespace ARNs.
+func ValidateNoLinkSharingPrincipals(tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		principal, _ := tfMap[names.AttrPrincipal].(string)
+		if principal == "" {
+			continue
+		}
+
+		if strings.Contains(principal, "*") {
+			return fmt.Errorf("principal (%s) is not supported: analyses don't support anonymous link sharing, share a dashboard published from the analysis instead", principal)
+		}
+	}
+
+	return nil
+}
+
+// DiffPermissions computes the permissions to grant and revoke to move from the old to the new permissions.
+// Entries for the same principal are merged before comparison so that overlapping entries don't
+// revoke actions that are still granted by another entry.
 func DiffPermissions(o, n []interface{}) ([]awstypes.ResourcePermission, []awstypes.ResourcePermission) {
-	old := ExpandResourcePermissions(o)
-	new := ExpandResourcePermissions(n)
+	oldPrincipals, old := permissionsByPrincipal(ExpandResourcePermissions(o))
+	newPrincipals, new := permissionsByPrincipal(ExpandResourcePermissions(n))
 
 	var toGrant, toRevoke []awstypes.ResourcePermission
 
-	for _, op := range old {
-		found := false
-
-		for _, np := range new {
-			if aws.ToString(np.Principal) != aws.ToString(op.Principal) {
-				continue
-			}
-
-			found = true
-			newActions := flex.FlattenStringValueSet(np.Actions)
-			oldActions := flex.FlattenStringValueSet(op.Actions)
-
-			if newActions.Equal(oldActions) {
-				break
-			}
-
-			toRemove := oldActions.Difference(newActions)
-
-			if toRemove.Len() > 0 {
-				toRevoke = append(toRevoke, awstypes.ResourcePermission{
-					Actions:   flex.ExpandStringValueSet(toRemove),
-					Principal: np.Principal,
-				})
-			}
-
-			if newActions.Len() > 0 {
-				toGrant = append(toGrant, awstypes.ResourcePermission{
-					Actions:   flex.ExpandStringValueSet(newActions),
-					Principal: np.Principal,
-				})
-			}
-		}
+	for _, principal := range oldPrincipals {
+		oldActions := old[principal]
+		newActions, found := new[principal]
 
 		if !found {
-			toRevoke = append(toRevoke, op)
+			toRevoke = append(toRevoke, awstypes.ResourcePermission{
+				Actions:   flex.ExpandStringValueSet(oldActions),
+				Principal: aws.String(principal),
+			})
+			continue
 		}
-	}
 
-	for _, np := range new {
-		found := false
+		if newActions.Equal(oldActions) {
+			continue
+		}
 
-		for _, op := range old {
-			if aws.ToString(np.Principal) == aws.ToString(op.Principal) {
-				found = true
-				break
-			}
+		if toRemove := oldActions.Difference(newActions); toRemove.Len() > 0 {
+			toRevoke = append(toRevoke, awstypes.ResourcePermission{
+				Actions:   flex.ExpandStringValueSet(toRemove),
+				Principal: aws.String(principal),
+			})
 		}
 
-		if !found {
-			toGrant = append(toGrant, np)
+		if newActions.Len() > 0 {
+			toGrant = append(toGrant, awstypes.ResourcePermission{
+				Actions:   flex.ExpandStringValueSet(newActions),
+				Principal: aws.String(principal),
+			})
 		}
 	}
 
+	for _, principal := range newPrincipals {
+		if _, found := old[principal]; found {
+			continue
+		}
+
+		toGrant = append(toGrant, awstypes.ResourcePermission{
+			Actions:   flex.ExpandStringValueSet(new[principal]),
+			Principal: aws.String(principal),
+		})
+	}
+
 	return toGrant, toRevoke
 }
+
+// permissionsByPrincipal merges the actions of permissions with the same principal.
+// The principals are returned in order of first appearance.
+func permissionsByPrincipal(apiObjects []awstypes.ResourcePermission) ([]string, map[string]*schema.Set) {
+	var principals []string
+	actions := make(map[string]*schema.Set)
+
+	for _, apiObject := range apiObjects {
+		principal := aws.ToString(apiObject.Principal)
+
+		if v, ok := actions[principal]; ok {
+			actions[principal] = v.Union(flex.FlattenStringValueSet(apiObject.Actions))
+			continue
+		}
+
+		principals = append(principals, principal)
+		actions[principal] = flex.FlattenStringValueSet(apiObject.Actions)
+	}
+
+	return principals, actions
+}
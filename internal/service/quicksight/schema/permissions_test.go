@@ -211,6 +211,145 @@ func TestDiffPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "overlapping principals;no changes",
+			oldPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+						"action2",
+					}),
+				},
+			},
+			newPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+					}),
+				},
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action2",
+					}),
+				},
+			},
+			expectedGrants:  nil,
+			expectedRevokes: nil,
+		},
+		{
+			name: "overlapping principals;revoke action",
+			oldPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+					}),
+				},
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action2",
+					}),
+				},
+			},
+			newPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+					}),
+				},
+			},
+			expectedGrants: []awstypes.ResourcePermission{
+				{
+					Actions:   []string{"action1"},
+					Principal: aws.String("principal1"),
+				},
+			},
+			expectedRevokes: []awstypes.ResourcePermission{
+				{
+					Actions:   []string{"action2"},
+					Principal: aws.String("principal1"),
+				},
+			},
+		},
+		{
+			name:           "overlapping principals;grant new principal",
+			oldPermissions: []interface{}{},
+			newPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+					}),
+				},
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action2",
+					}),
+				},
+			},
+			expectedGrants: []awstypes.ResourcePermission{
+				{
+					Actions:   []string{"action1", "action2"},
+					Principal: aws.String("principal1"),
+				},
+			},
+			expectedRevokes: nil,
+		},
+		{
+			name: "overlapping actions across principals",
+			oldPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+						"action2",
+					}),
+				},
+				map[string]interface{}{
+					names.AttrPrincipal: "principal2",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+					}),
+				},
+			},
+			newPermissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "principal1",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+					}),
+				},
+				map[string]interface{}{
+					names.AttrPrincipal: "principal2",
+					names.AttrActions: schema.NewSet(schema.HashString, []interface{}{
+						"action1",
+						"action2",
+					}),
+				},
+			},
+			expectedGrants: []awstypes.ResourcePermission{
+				{
+					Actions:   []string{"action1"},
+					Principal: aws.String("principal1"),
+				},
+				{
+					Actions:   []string{"action1", "action2"},
+					Principal: aws.String("principal2"),
+				},
+			},
+			expectedRevokes: []awstypes.ResourcePermission{
+				{
+					Actions:   []string{"action2"},
+					Principal: aws.String("principal1"),
+				},
+			},
+		},
 	}
 
 	ignoreExportedOpts := cmpopts.IgnoreUnexported(
@@ -231,3 +370,58 @@ func TestDiffPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNoLinkSharingPrincipals(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		permissions []interface{}
+		expectError bool
+	}{
+		{
+			name:        "empty",
+			permissions: []interface{}{},
+		},
+		{
+			name: "user and namespace",
+			permissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "arn:aws:quicksight:us-west-2:123456789012:user/default/example", //lintignore:AWSAT003,AWSAT005
+				},
+				map[string]interface{}{
+					names.AttrPrincipal: "arn:aws:quicksight:us-west-2:123456789012:namespace/default", //lintignore:AWSAT003,AWSAT005
+				},
+			},
+		},
+		{
+			name: "anonymous",
+			permissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "*",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "wildcard ARN",
+			permissions: []interface{}{
+				map[string]interface{}{
+					names.AttrPrincipal: "arn:aws:quicksight:us-west-2:123456789012:user/default/*", //lintignore:AWSAT003,AWSAT005
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateNoLinkSharingPrincipals(testCase.permissions)
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateNoLinkSharingPrincipals() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
@@ -184,6 +184,18 @@ type stringLenBetweenIdentity struct {
 	min, max int
 }
 
+// templateARNSchema returns the schema for the ARN of a source template.
+// The template may be in a different account or Region from the referencing resource.
+func templateARNSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validTemplateARN,
+	}
+}
+
+var validTemplateARN = validResourceARN("template", "template")
+
 var stringLenBetweenSchemaCache syncMap[stringLenBetweenIdentity, *schema.Schema]
 
 func stringLenBetweenSchema(handling attrHandling, min, max int) *schema.Schema {
@@ -640,7 +652,7 @@ func TemplateSourceEntitySchema() *schema.Schema {
 					ExactlyOneOf: []string{"source_entity.0.source_analysis", "source_entity.0.source_template"},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
-							names.AttrARN: arnStringSchema(attrRequired),
+							names.AttrARN: templateARNSchema(),
 						},
 					},
 				},
@@ -4,6 +4,7 @@
 package schema
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -415,6 +416,69 @@ func parameterNameSchema(required bool) *schema.Schema {
 	}
 }
 
+// parameterDeclarationTypes are the keys of the typed declarations in a parameter_declarations element.
+var parameterDeclarationTypes = []string{
+	"date_time_parameter_declaration",
+	"decimal_parameter_declaration",
+	"integer_parameter_declaration",
+	"string_parameter_declaration",
+}
+
+// ValidateParameterDeclarations checks the parameter_declarations of an analysis or dashboard definition.
+// Each declaration must configure exactly one parameter type, parameter names must be unique, and
+// SINGLE_VALUED parameters can have at most one static default value.
+func ValidateParameterDeclarations(tfList []interface{}) error {
+	declared := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var declarationType string
+		var declaration map[string]interface{}
+		for _, k := range parameterDeclarationTypes {
+			v, ok := tfMap[k].([]interface{})
+			if !ok || len(v) == 0 || v[0] == nil {
+				continue
+			}
+
+			if declarationType != "" {
+				return fmt.Errorf("parameter_declarations: only one of %s and %s can be configured in a declaration", declarationType, k)
+			}
+
+			declarationType, declaration = k, v[0].(map[string]interface{})
+		}
+
+		if declaration == nil {
+			return fmt.Errorf("parameter_declarations: one of %v must be configured in each declaration", parameterDeclarationTypes)
+		}
+
+		name, _ := declaration[names.AttrName].(string)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := declared[name]; ok {
+			return fmt.Errorf("parameter_declarations: parameter (%s) is declared more than once", name)
+		}
+		declared[name] = struct{}{}
+
+		if declaration["parameter_value_type"] != string(awstypes.ParameterValueTypeSingleValued) {
+			continue
+		}
+
+		if v, ok := declaration["default_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["static_values"].([]interface{}); ok && len(v) > 1 {
+				return fmt.Errorf("parameter_declarations: %s parameter (%s) is %s but has %d default static_values", declarationType, name, awstypes.ParameterValueTypeSingleValued, len(v))
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandDateTimeParameterDeclaration(tfList []interface{}) *awstypes.DateTimeParameterDeclaration {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidTemplateARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "same Region",
+			value: "arn:aws:quicksight:us-west-2:123456789012:template/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:  "cross-Region",
+			value: "arn:aws:quicksight:eu-west-1:123456789012:template/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:  "cross-account",
+			value: "arn:aws:quicksight:us-west-2:210987654321:template/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:        "not an ARN",
+			value:       "template/example",
+			expectError: true,
+		},
+		{
+			name:        "other service",
+			value:       "arn:aws:s3:us-west-2:123456789012:template/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name:        "no Region",
+			value:       "arn:aws:quicksight::123456789012:template/example", //lintignore:AWSAT005
+			expectError: true,
+		},
+		{
+			name:        "analysis",
+			value:       "arn:aws:quicksight:us-west-2:123456789012:analysis/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name:        "no template ID",
+			value:       "arn:aws:quicksight:us-west-2:123456789012:template/", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validTemplateARN(testCase.value, names.AttrARN)
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Errorf("errors = %v, expectError = %t", errs, want)
+			}
+		})
+	}
+}
+
+func TestValidateParameterDeclarations(t *testing.T) {
+	t.Parallel()
+
+	declaration := func(declarationType, name string, parameterValueType string, staticValues ...interface{}) interface{} {
+		tfMap := map[string]interface{}{
+			names.AttrName: name,
+		}
+		if parameterValueType != "" {
+			tfMap["parameter_value_type"] = parameterValueType
+		}
+		if len(staticValues) > 0 {
+			tfMap["default_values"] = []interface{}{map[string]interface{}{
+				"static_values": staticValues,
+			}}
+		}
+
+		return map[string]interface{}{
+			declarationType: []interface{}{tfMap},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		declarations []interface{}
+		expectError  bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			declarations: []interface{}{
+				declaration("date_time_parameter_declaration", "DateTimeParam", "", "2024-01-01T00:00:00Z"),
+				declaration("integer_parameter_declaration", "IntegerParam", "SINGLE_VALUED", 1),
+				declaration("string_parameter_declaration", "StringParam", "MULTI_VALUED", "a", "b"),
+			},
+		},
+		{
+			name: "single-valued with multiple defaults",
+			declarations: []interface{}{
+				declaration("decimal_parameter_declaration", "DecimalParam", "SINGLE_VALUED", 1.0, 2.0),
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate name",
+			declarations: []interface{}{
+				declaration("integer_parameter_declaration", "Param", "SINGLE_VALUED"),
+				declaration("string_parameter_declaration", "Param", "SINGLE_VALUED"),
+			},
+			expectError: true,
+		},
+		{
+			name: "multiple types",
+			declarations: []interface{}{
+				map[string]interface{}{
+					"integer_parameter_declaration": []interface{}{map[string]interface{}{names.AttrName: "IntegerParam"}},
+					"string_parameter_declaration":  []interface{}{map[string]interface{}{names.AttrName: "StringParam"}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "no type",
+			declarations: []interface{}{
+				map[string]interface{}{
+					"integer_parameter_declaration": []interface{}{},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateParameterDeclarations(testCase.declarations)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateParameterDeclarations() error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
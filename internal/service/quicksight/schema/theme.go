@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 )
@@ -121,8 +122,10 @@ func ThemeConfigurationSchema() *schema.Schema {
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"font_family": {
-											Type:     schema.TypeString,
-											Optional: true,
+											// QuickSight documents no fixed set of font families, and custom fonts may be used.
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringIsNotWhiteSpace,
 										},
 									},
 								},
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestExpandThemeConfigurationTypography(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		tfList   []interface{}
+		expected *awstypes.ThemeConfiguration
+	}{
+		{
+			name: "two font families",
+			tfList: []interface{}{map[string]interface{}{
+				"typography": []interface{}{map[string]interface{}{
+					"font_families": []interface{}{
+						map[string]interface{}{"font_family": "monospace"},
+						map[string]interface{}{"font_family": "Roboto"},
+					},
+				}},
+			}},
+			expected: &awstypes.ThemeConfiguration{
+				Typography: &awstypes.Typography{
+					FontFamilies: []awstypes.Font{
+						{FontFamily: aws.String("monospace")},
+						{FontFamily: aws.String("Roboto")},
+					},
+				},
+			},
+		},
+		{
+			name: "no font families",
+			tfList: []interface{}{map[string]interface{}{
+				"typography": []interface{}{map[string]interface{}{}},
+			}},
+			expected: &awstypes.ThemeConfiguration{
+				Typography: &awstypes.Typography{},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := ExpandThemeConfiguration(testCase.tfList)
+
+			if diff := cmp.Diff(apiObject, testCase.expected, cmpopts.IgnoreUnexported(awstypes.ThemeConfiguration{}, awstypes.Typography{}, awstypes.Font{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(FlattenThemeConfiguration(apiObject), testCase.tfList); diff != "" {
+				t.Errorf("unexpected flattened diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	versionDescriptionMaxLen = 512
+)
+
+// ValidVersionDescription validates the version_description argument of versioned resources (dashboards, templates and themes).
+func ValidVersionDescription() schema.SchemaValidateFunc {
+	return validation.StringLenBetween(1, versionDescriptionMaxLen)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidVersionDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:        "empty",
+			value:       "",
+			expectError: true,
+		},
+		{
+			name:  "minimum length",
+			value: "a",
+		},
+		{
+			name:  "maximum length",
+			value: strings.Repeat("a", versionDescriptionMaxLen),
+		},
+		{
+			name:        "over maximum length",
+			value:       strings.Repeat("a", versionDescriptionMaxLen+1),
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := ValidVersionDescription()(testCase.value, "version_description")
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Errorf("errors = %v, expectError = %t", errs, want)
+			}
+		})
+	}
+}
@@ -43,6 +43,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newRefreshScheduleResource,
 			Name:    "Refresh Schedule",
 		},
+		{
+			Factory: newRoleMembershipResource,
+			Name:    "Role Membership",
+		},
 		{
 			Factory: newTemplateAliasResource,
 			Name:    "Template Alias",
@@ -59,6 +63,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccountSettings,
+			TypeName: "aws_quicksight_account_settings",
+			Name:     "Account Settings",
+		},
+		{
+			Factory:  dataSourceAccountSubscription,
+			TypeName: "aws_quicksight_account_subscription",
+			Name:     "Account Subscription",
+		},
 		{
 			Factory:  dataSourceAnalysis,
 			TypeName: "aws_quicksight_analysis",
@@ -77,6 +91,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_quicksight_group",
 			Name:     "Group",
 		},
+		{
+			Factory:  dataSourceTemplatePermissions,
+			TypeName: "aws_quicksight_template_permissions",
+			Name:     "Template Permissions",
+		},
 		{
 			Factory:  dataSourceTheme,
 			TypeName: "aws_quicksight_theme",
@@ -87,6 +106,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_quicksight_user",
 			Name:     "User",
 		},
+		{
+			Factory:  dataSourceUsers,
+			TypeName: "aws_quicksight_users",
+			Name:     "Users",
+		},
+		{
+			Factory:  dataSourceVPCConnection,
+			TypeName: "aws_quicksight_vpc_connection",
+			Name:     "VPC Connection",
+		},
+		{
+			Factory:  dataSourceVPCConnections,
+			TypeName: "aws_quicksight_vpc_connections",
+			Name:     "VPC Connections",
+		},
 	}
 }
 
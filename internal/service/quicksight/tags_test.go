@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+)
+
+func TestUpdateTagsAPICalls(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldTags  map[string]string
+		newTags  map[string]string
+		expected []string
+	}{
+		"unchanged": {
+			oldTags: map[string]string{"key1": "value1", "key2": "value2"},
+			newTags: map[string]string{"key1": "value1", "key2": "value2"},
+		},
+		"empty": {},
+		"added": {
+			oldTags:  map[string]string{"key1": "value1"},
+			newTags:  map[string]string{"key1": "value1", "key2": "value2"},
+			expected: []string{"TagResource"},
+		},
+		"removed": {
+			oldTags:  map[string]string{"key1": "value1", "key2": "value2"},
+			newTags:  map[string]string{"key1": "value1"},
+			expected: []string{"UntagResource"},
+		},
+		"changed and removed": {
+			oldTags:  map[string]string{"key1": "value1", "key2": "value2"},
+			newTags:  map[string]string{"key1": "value1updated"},
+			expected: []string{"UntagResource", "TagResource"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var calls []string
+			conn := newTestClient(func(input any) (any, error) {
+				switch input.(type) {
+				case *quicksight.TagResourceInput:
+					calls = append(calls, "TagResource")
+					return &quicksight.TagResourceOutput{}, nil
+				case *quicksight.UntagResourceInput:
+					calls = append(calls, "UntagResource")
+					return &quicksight.UntagResourceOutput{}, nil
+				default:
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+			})
+
+			err := tfquicksight.UpdateTags(ctx, conn, "arn:aws:quicksight:us-west-2:123456789012:dashboard/example", testCase.oldTags, testCase.newTags) //lintignore:AWSAT003,AWSAT005
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(calls, testCase.expected); diff != "" {
+				t.Errorf("unexpected API calls (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,6 +55,24 @@ func resourceTemplate() *schema.Resource {
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
+				names.AttrAlias: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"alias_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 2048),
+							},
+							"template_version_number": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
 				names.AttrARN: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -91,26 +111,58 @@ func resourceTemplate() *schema.Resource {
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
 				"template_id": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: quicksightschema.ValidResourceID(quicksightschema.ResourceIDMaxLen),
 				},
 				"version_description": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: validation.StringLenBetween(1, 512),
+					ValidateFunc: quicksightschema.ValidVersionDescription(),
 				},
 				"version_number": {
 					Type:     schema.TypeInt,
 					Computed: true,
 				},
+				"version_retention_count": versionRetentionCountSchema(),
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			templateAliasesCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func templateAliasesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	v, ok := diff.Get(names.AttrAlias).(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	for _, tfMapRaw := range v.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		aliasName := tfMap["alias_name"].(string)
+		if aliasName == "" {
+			continue
+		}
+
+		if _, ok := seen[aliasName]; ok {
+			return fmt.Errorf("%s: alias_name (%s) is configured more than once", names.AttrAlias, aliasName)
+		}
+		seen[aliasName] = struct{}{}
+	}
+
+	return nil
+}
+
 func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
@@ -138,6 +190,10 @@ func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.SourceEntity = quicksightschema.ExpandTemplateSourceEntity(v.([]interface{}))
+
+		if err := checkTemplateSourceAnalysisDataSetReferences(ctx, conn, input.SourceEntity); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating QuickSight Template (%s): %s", id, err)
+		}
 	}
 
 	if v, ok := d.GetOk("version_description"); ok {
@@ -156,6 +212,12 @@ func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for QuickSight Template (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk(names.AttrAlias); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateTemplateAliases(ctx, conn, awsAccountID, templateID, nil, v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating QuickSight Template (%s) aliases: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceTemplateRead(ctx, d, meta)...)
 }
 
@@ -212,6 +274,30 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting permissions: %s", err)
 	}
 
+	// Only the aliases managed by this resource are read, so that aliases managed elsewhere
+	// (e.g. by aws_quicksight_template_alias) don't show up as drift.
+	var aliases []interface{}
+	for aliasName := range expandTemplateAliases(d.Get(names.AttrAlias).(*schema.Set).List()) {
+		alias, err := findTemplateAliasByThreePartKey(ctx, conn, awsAccountID, templateID, aliasName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading QuickSight Template (%s) alias (%s): %s", d.Id(), aliasName, err)
+		}
+
+		aliases = append(aliases, map[string]interface{}{
+			"alias_name":              aws.ToString(alias.AliasName),
+			"template_version_number": aws.ToInt64(alias.TemplateVersionNumber),
+		})
+	}
+
+	if err := d.Set(names.AttrAlias, aliases); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alias: %s", err)
+	}
+
 	return diags
 }
 
@@ -224,7 +310,7 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrAlias, names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "version_retention_count") {
 		input := &quicksight.UpdateTemplateInput{
 			AwsAccountId:       aws.String(awsAccountID),
 			Name:               aws.String(d.Get(names.AttrName).(string)),
@@ -235,6 +321,10 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		// One of source_entity or definition is required for update
 		if v, ok := d.GetOk("source_entity"); ok {
 			input.SourceEntity = quicksightschema.ExpandTemplateSourceEntity(v.([]interface{}))
+
+			if err := checkTemplateSourceAnalysisDataSetReferences(ctx, conn, input.SourceEntity); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating QuickSight Template (%s): %s", d.Id(), err)
+			}
 		} else {
 			input.Definition = quicksightschema.ExpandTemplateDefinition(d.Get("definition").([]interface{}))
 		}
@@ -250,6 +340,21 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Aliases are moved before pruning so that versions they no longer reference can be pruned.
+	if d.HasChange(names.AttrAlias) {
+		o, n := d.GetChange(names.AttrAlias)
+
+		if err := updateTemplateAliases(ctx, conn, awsAccountID, templateID, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating QuickSight Template (%s) aliases: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("version_retention_count"); ok {
+		if err := pruneTemplateVersions(ctx, conn, awsAccountID, templateID, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning QuickSight Template (%s) versions: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrPermissions) {
 		o, n := d.GetChange(names.AttrPermissions)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
@@ -323,6 +428,141 @@ func templateParseResourceID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// checkTemplateSourceAnalysisDataSetReferences checks that a source analysis's data_set_references cover every
+// data set used by the analysis, which CreateTemplate otherwise rejects without naming the missing data set.
+// The check is skipped if the analysis can't be described, e.g. when it's shared from another account.
+func checkTemplateSourceAnalysisDataSetReferences(ctx context.Context, conn *quicksight.Client, apiObject *awstypes.TemplateSourceEntity) error {
+	if apiObject == nil || apiObject.SourceAnalysis == nil {
+		return nil
+	}
+
+	analysisARN := aws.ToString(apiObject.SourceAnalysis.Arn)
+	awsAccountID, analysisID, err := parseAnalysisARN(analysisARN)
+	if err != nil {
+		return nil
+	}
+
+	analysis, err := findAnalysisByTwoPartKey(ctx, conn, awsAccountID, analysisID)
+	if err != nil {
+		log.Printf("[WARN] Skipping data_set_references check, reading QuickSight Analysis (%s): %s", analysisARN, err)
+		return nil
+	}
+
+	if missing := templateSourceAnalysisMissingDataSets(analysis.DataSetArns, apiObject.SourceAnalysis.DataSetReferences); len(missing) > 0 {
+		return fmt.Errorf("source_entity.0.source_analysis.0.data_set_references: no reference for data sets used by analysis (%s): %s", analysisARN, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// templateSourceAnalysisMissingDataSets returns the data set ARNs that aren't referenced by any of the data set references.
+func templateSourceAnalysisMissingDataSets(dataSetARNs []string, references []awstypes.DataSetReference) []string {
+	var missing []string
+
+	for _, dataSetARN := range dataSetARNs {
+		if !slices.ContainsFunc(references, func(v awstypes.DataSetReference) bool {
+			return aws.ToString(v.DataSetArn) == dataSetARN
+		}) {
+			missing = append(missing, dataSetARN)
+		}
+	}
+
+	return missing
+}
+
+func expandTemplateAliases(tfList []interface{}) map[string]int64 {
+	aliases := make(map[string]int64, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		aliases[tfMap["alias_name"].(string)] = int64(tfMap["template_version_number"].(int))
+	}
+
+	return aliases
+}
+
+// diffTemplateAliases returns the aliases to create, the aliases to move to another template version
+// and the names of the aliases to delete to move from the old to the new alias configuration.
+func diffTemplateAliases(o, n []interface{}) (map[string]int64, map[string]int64, []string) {
+	old, new := expandTemplateAliases(o), expandTemplateAliases(n)
+	toCreate, toUpdate := make(map[string]int64), make(map[string]int64)
+	var toDelete []string
+
+	for aliasName, version := range new {
+		oldVersion, ok := old[aliasName]
+
+		if !ok {
+			toCreate[aliasName] = version
+			continue
+		}
+
+		if oldVersion != version {
+			toUpdate[aliasName] = version
+		}
+	}
+
+	for aliasName := range old {
+		if _, ok := new[aliasName]; !ok {
+			toDelete = append(toDelete, aliasName)
+		}
+	}
+	slices.Sort(toDelete)
+
+	return toCreate, toUpdate, toDelete
+}
+
+func updateTemplateAliases(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string, o, n []interface{}) error {
+	toCreate, toUpdate, toDelete := diffTemplateAliases(o, n)
+
+	for _, aliasName := range toDelete {
+		_, err := conn.DeleteTemplateAlias(ctx, &quicksight.DeleteTemplateAliasInput{
+			AliasName:    aws.String(aliasName),
+			AwsAccountId: aws.String(awsAccountID),
+			TemplateId:   aws.String(templateID),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting alias (%s): %w", aliasName, err)
+		}
+	}
+
+	for aliasName, version := range toUpdate {
+		_, err := conn.UpdateTemplateAlias(ctx, &quicksight.UpdateTemplateAliasInput{
+			AliasName:             aws.String(aliasName),
+			AwsAccountId:          aws.String(awsAccountID),
+			TemplateId:            aws.String(templateID),
+			TemplateVersionNumber: aws.Int64(version),
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating alias (%s): %w", aliasName, err)
+		}
+	}
+
+	for aliasName, version := range toCreate {
+		_, err := conn.CreateTemplateAlias(ctx, &quicksight.CreateTemplateAliasInput{
+			AliasName:             aws.String(aliasName),
+			AwsAccountId:          aws.String(awsAccountID),
+			TemplateId:            aws.String(templateID),
+			TemplateVersionNumber: aws.Int64(version),
+		})
+
+		if err != nil {
+			return fmt.Errorf("creating alias (%s): %w", aliasName, err)
+		}
+	}
+
+	return nil
+}
+
 func findTemplateByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string) (*awstypes.Template, error) {
 	input := &quicksight.DescribeTemplateInput{
 		AwsAccountId: aws.String(awsAccountID),
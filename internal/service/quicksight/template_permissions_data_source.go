@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_quicksight_template_permissions", name="Template Permissions")
+func dataSourceTemplatePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTemplatePermissionsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrAWSAccountID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				names.AttrPermissions: quicksightschema.PermissionsDataSourceSchema(),
+				"template_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceTemplatePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+	templateID := d.Get("template_id").(string)
+	id := templateCreateResourceID(awsAccountID, templateID)
+
+	permissions, err := findTemplatePermissionsByTwoPartKey(ctx, conn, awsAccountID, templateID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight Template (%s) permissions: %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrAWSAccountID, awsAccountID)
+	if err := d.Set(names.AttrPermissions, quicksightschema.FlattenPermissions(permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting permissions: %s", err)
+	}
+	d.Set("template_id", templateID)
+
+	return diags
+}
@@ -7,8 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -21,6 +25,103 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDiffTemplateAliases(t *testing.T) {
+	t.Parallel()
+
+	alias := func(aliasName string, version int) interface{} {
+		return map[string]interface{}{
+			"alias_name":              aliasName,
+			"template_version_number": version,
+		}
+	}
+
+	testCases := map[string]struct {
+		old, new         []interface{}
+		expectedToCreate map[string]int64
+		expectedToUpdate map[string]int64
+		expectedToDelete []string
+	}{
+		"empty": {},
+		"unchanged": {
+			old: []interface{}{alias("PROD", 1), alias("STAGING", 2)},
+			new: []interface{}{alias("PROD", 1), alias("STAGING", 2)},
+		},
+		"create": {
+			new:              []interface{}{alias("PROD", 1), alias("STAGING", 1)},
+			expectedToCreate: map[string]int64{"PROD": 1, "STAGING": 1},
+		},
+		"move": {
+			old:              []interface{}{alias("PROD", 1), alias("STAGING", 2)},
+			new:              []interface{}{alias("PROD", 2), alias("STAGING", 3)},
+			expectedToUpdate: map[string]int64{"PROD": 2, "STAGING": 3},
+		},
+		"create, move and delete": {
+			old:              []interface{}{alias("DEV", 1), alias("PROD", 1), alias("STAGING", 2)},
+			new:              []interface{}{alias("PROD", 2), alias("QA", 2)},
+			expectedToCreate: map[string]int64{"QA": 2},
+			expectedToUpdate: map[string]int64{"PROD": 2},
+			expectedToDelete: []string{"DEV", "STAGING"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toCreate, toUpdate, toDelete := tfquicksight.DiffTemplateAliases(testCase.old, testCase.new)
+
+			if got, want := toCreate, testCase.expectedToCreate; !maps.Equal(got, want) {
+				t.Errorf("toCreate = %v, want %v", got, want)
+			}
+			if got, want := toUpdate, testCase.expectedToUpdate; !maps.Equal(got, want) {
+				t.Errorf("toUpdate = %v, want %v", got, want)
+			}
+			if got, want := toDelete, testCase.expectedToDelete; !slices.Equal(got, want) {
+				t.Errorf("toDelete = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTemplateSourceAnalysisMissingDataSets(t *testing.T) {
+	t.Parallel()
+
+	dataSet1 := "arn:aws:quicksight:us-west-2:123456789012:dataset/one" //lintignore:AWSAT003,AWSAT005
+	dataSet2 := "arn:aws:quicksight:us-west-2:123456789012:dataset/two" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		dataSetARNs []string
+		references  []awstypes.DataSetReference
+		expected    []string
+	}{
+		"none": {},
+		"all referenced": {
+			dataSetARNs: []string{dataSet1, dataSet2},
+			references: []awstypes.DataSetReference{
+				{DataSetArn: aws.String(dataSet2), DataSetPlaceholder: aws.String("2")},
+				{DataSetArn: aws.String(dataSet1), DataSetPlaceholder: aws.String("1")},
+			},
+		},
+		"missing": {
+			dataSetARNs: []string{dataSet1, dataSet2},
+			references: []awstypes.DataSetReference{
+				{DataSetArn: aws.String(dataSet1), DataSetPlaceholder: aws.String("1")},
+			},
+			expected: []string{dataSet2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfquicksight.TemplateSourceAnalysisMissingDataSets(testCase.dataSetARNs, testCase.references), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("TemplateSourceAnalysisMissingDataSets = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccQuickSightTemplate_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.Template
@@ -198,6 +299,33 @@ func TestAccQuickSightTemplate_sourceEntity(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightTemplate_sourceEntityValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTemplateConfig_sourceEntityBoth(rId, rName, sourceId, sourceName),
+				ExpectError: regexache.MustCompile(`only one of .source_entity.0.source_analysis,source_entity.0.source_template.\s+can be specified`),
+			},
+			{
+				Config:      testAccTemplateConfig_sourceAnalysisMissingReference(rId, rName, sourceId, sourceName),
+				ExpectError: regexache.MustCompile(`source_entity.0.source_analysis.0.data_set_references: no reference for data sets used by analysis`),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightTemplate_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.Template
@@ -249,6 +377,169 @@ func TestAccQuickSightTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightTemplate_permissionsCrossAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.Template
+	resourceName := "aws_quicksight_template.test"
+	dataSourceName := "data.aws_quicksight_template_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_permissionsCrossAccount(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct1),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]*regexp.Regexp{
+						names.AttrPrincipal: regexache.MustCompile(`^arn:[^:]+:iam::\d{12}:root$`),
+						"actions.#":         regexache.MustCompile(`^2$`),
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*.actions.*", "quicksight:DescribeTemplate"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*.actions.*", "quicksight:DescribeTemplatePermissions"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.#", acctest.Ct1),
+					resource.TestMatchResourceAttr(dataSourceName, "permissions.0.principal", regexache.MustCompile(`^arn:[^:]+:iam::\d{12}:root$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.0.actions.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateConfig_permissionsCrossAccountRevoked(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct1),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]*regexp.Regexp{
+						"actions.#": regexache.MustCompile(`^1$`),
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*.actions.*", "quicksight:DescribeTemplate"),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTemplate_versionRetentionCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_versionRetentionCount(rId, rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_retention_count", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_versionRetentionCount(rId, rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+					testAccCheckTemplateVersions(ctx, resourceName, 1, 2),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_versionRetentionCount(rId, rName, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct3),
+					// Version 1 is protected by the alias, version 2 is pruned.
+					testAccCheckTemplateVersions(ctx, resourceName, 1, 3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTemplate_aliases(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_aliases(rId, rName, "first", 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "alias.#", acctest.Ct2),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "PROD", 1),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "STAGING", 1),
+					// Aliases managed by aws_quicksight_template_alias aren't read into state.
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "standalone", 1),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_aliases(rId, rName, "second", 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "alias.#", acctest.Ct2),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "PROD", 1),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "STAGING", 2),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_aliases(rId, rName, "third", 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "alias.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "alias.*", map[string]string{
+						"alias_name":              "PROD",
+						"template_version_number": acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "alias.*", map[string]string{
+						"alias_name":              "STAGING",
+						"template_version_number": acctest.Ct3,
+					}),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "PROD", 2),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "STAGING", 3),
+					testAccCheckTemplateAliasVersion(ctx, resourceName, "standalone", 1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
@@ -296,6 +587,53 @@ func testAccCheckTemplateExists(ctx context.Context, n string, v *awstypes.Templ
 	}
 }
 
+func testAccCheckTemplateVersions(ctx context.Context, n string, want ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		got, err := tfquicksight.FindTemplateVersionNumbers(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("QuickSight Template (%s) versions = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateAliasVersion(ctx context.Context, n, aliasName string, want int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		output, err := tfquicksight.FindTemplateAliasByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["template_id"], aliasName)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToInt64(output.TemplateVersionNumber); got != want {
+			return fmt.Errorf("QuickSight Template (%s) alias (%s) version = %d, want %d", rs.Primary.ID, aliasName, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTemplateNotRecreated(before, after *awstypes.Template) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if creationTimeBefore, creationTimeAfter := aws.ToTime(before.CreatedTime), aws.ToTime(after.CreatedTime); creationTimeBefore != creationTimeAfter {
@@ -431,6 +769,178 @@ resource "aws_quicksight_template" "test" {
 `, rId, rName))
 }
 
+func testAccTemplateConfig_permissionsCrossAccountBase(rId, rName, actions string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccTemplateConfig_base(rId, rName),
+		fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[2]q
+  version_description = "test"
+
+  permissions {
+    actions   = %[3]s
+    principal = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.alternate.account_id}:root"
+  }
+
+  definition {
+    data_set_configuration {
+      data_set_schema {
+        column_schema_list {
+          name      = "Column1"
+          data_type = "STRING"
+        }
+      }
+      placeholder = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+
+data "aws_quicksight_template_permissions" "test" {
+  template_id = aws_quicksight_template.test.template_id
+}
+`, rId, rName, actions))
+}
+
+func testAccTemplateConfig_permissionsCrossAccount(rId, rName string) string {
+	return testAccTemplateConfig_permissionsCrossAccountBase(rId, rName, `["quicksight:DescribeTemplate", "quicksight:DescribeTemplatePermissions"]`)
+}
+
+func testAccTemplateConfig_permissionsCrossAccountRevoked(rId, rName string) string {
+	return testAccTemplateConfig_permissionsCrossAccountBase(rId, rName, `["quicksight:DescribeTemplate"]`)
+}
+
+func testAccTemplateConfig_versionRetentionCount(rId, rName, versionDescription string) string {
+	return acctest.ConfigCompose(
+		testAccTemplateConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id             = %[1]q
+  name                    = %[2]q
+  version_description     = %[3]q
+  version_retention_count = 1
+
+  definition {
+    data_set_configuration {
+      data_set_schema {
+        column_schema_list {
+          name      = "Column1"
+          data_type = "STRING"
+        }
+        column_schema_list {
+          name      = "Column2"
+          data_type = "INTEGER"
+        }
+      }
+      placeholder = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = %[3]q
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_quicksight_template_alias" "test" {
+  alias_name              = "protected"
+  template_id             = aws_quicksight_template.test.template_id
+  template_version_number = 1
+}
+`, rId, rName, versionDescription))
+}
+
+func testAccTemplateConfig_aliases(rId, rName, versionDescription string, prodVersion, stagingVersion int) string {
+	return acctest.ConfigCompose(
+		testAccTemplateConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[2]q
+  version_description = %[3]q
+
+  alias {
+    alias_name              = "PROD"
+    template_version_number = %[4]d
+  }
+
+  alias {
+    alias_name              = "STAGING"
+    template_version_number = %[5]d
+  }
+
+  definition {
+    data_set_configuration {
+      data_set_schema {
+        column_schema_list {
+          name      = "Column1"
+          data_type = "STRING"
+        }
+        column_schema_list {
+          name      = "Column2"
+          data_type = "INTEGER"
+        }
+      }
+      placeholder = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = %[3]q
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_quicksight_template_alias" "test" {
+  alias_name              = "standalone"
+  template_id             = aws_quicksight_template.test.template_id
+  template_version_number = 1
+}
+`, rId, rName, versionDescription, prodVersion, stagingVersion))
+}
+
 func testAccTemplateConfig_BarChart(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccTemplateConfig_base(rId, rName),
@@ -575,6 +1085,51 @@ resource "aws_quicksight_template" "test" {
 `, rId, rName, sortDirection, totalPlacement))
 }
 
+func testAccTemplateConfig_sourceEntityBoth(rId, rName, sourceId, sourceName string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfig_basic(sourceId, sourceName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  source_entity {
+    source_analysis {
+      arn = aws_quicksight_analysis.test.arn
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = "1"
+      }
+    }
+    source_template {
+      arn = aws_quicksight_analysis.test.arn
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccTemplateConfig_sourceAnalysisMissingReference(rId, rName, sourceId, sourceName string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfig_basic(sourceId, sourceName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[2]q
+  version_description = "test"
+  source_entity {
+    source_analysis {
+      arn = aws_quicksight_analysis.test.arn
+      data_set_references {
+        data_set_arn         = "${aws_quicksight_data_set.test.arn}-other"
+        data_set_placeholder = "1"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
 func testAccTemplateConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
 	return acctest.ConfigCompose(
 		testAccTemplateConfig_BarChart(sourceId, sourceName),
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -74,9 +76,10 @@ func resourceTheme() *schema.Resource {
 					Computed: true,
 				},
 				"theme_id": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: quicksightschema.ValidResourceID(quicksightschema.ResourceIDMaxLen),
 				},
 				names.AttrLastUpdatedTime: {
 					Type:     schema.TypeString,
@@ -97,19 +100,62 @@ func resourceTheme() *schema.Resource {
 				"version_description": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: validation.StringLenBetween(1, 512),
+					ValidateFunc: quicksightschema.ValidVersionDescription(),
 				},
 				"version_number": {
 					Type:     schema.TypeInt,
 					Computed: true,
 				},
+				"version_retention_count": versionRetentionCountSchema(),
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			themeBaseThemeIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// starterThemeIDs are the IDs of the QuickSight-managed starting themes that all custom themes inherit from.
+var starterThemeIDs = []string{
+	"CLASSIC",
+	"MIDNIGHT",
+	"RAINIER",
+	"SEASIDE",
+}
+
+// themeBaseThemeIDCustomizeDiff verifies at plan time that base_theme_id refers to either a starting theme or an existing theme.
+func themeBaseThemeIDCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("base_theme_id") || !d.NewValueKnown("base_theme_id") {
+		return nil
+	}
+
+	baseThemeID := d.Get("base_theme_id").(string)
+	if slices.Contains(starterThemeIDs, baseThemeID) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+
+	_, err := findThemeByTwoPartKey(ctx, conn, awsAccountID, baseThemeID)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("base_theme_id (%s) must be one of the QuickSight starting themes (%s) or the ID of an existing theme", baseThemeID, strings.Join(starterThemeIDs, ", "))
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading QuickSight Theme (%s): %w", baseThemeID, err)
+	}
+
+	return nil
+}
+
 func resourceThemeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
@@ -148,8 +194,20 @@ func resourceThemeCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(id)
 
-	if _, err := waitThemeCreated(ctx, conn, awsAccountID, themeID, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for QuickSight Theme (%s) create: %s", d.Id(), err)
+	if output, err := waitThemeCreated(ctx, conn, awsAccountID, themeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		// A theme whose first version failed to create is unusable, so remove it instead of persisting a tainted resource.
+		if output != nil && output.Version.Status == awstypes.ResourceStatusCreationFailed {
+			_, deleteErr := conn.DeleteTheme(ctx, &quicksight.DeleteThemeInput{
+				AwsAccountId: aws.String(awsAccountID),
+				ThemeId:      aws.String(themeID),
+			})
+
+			if deleteErr == nil || errs.IsA[*awstypes.ResourceNotFoundException](deleteErr) {
+				d.SetId("")
+			}
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for QuickSight Theme (%s) create: %s", id, err)
 	}
 
 	return append(diags, resourceThemeRead(ctx, d, meta)...)
@@ -190,6 +248,11 @@ func resourceThemeRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("version_description", theme.Version.Description)
 	d.Set("version_number", theme.Version.VersionNumber)
 
+	// Failed versions still exist, so surface their errors without failing the read.
+	if versionErrors := themeVersionErrors(theme.Version); len(versionErrors) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "QuickSight Theme (%s) version %d is %s: %s", d.Id(), aws.ToInt64(theme.Version.VersionNumber), theme.Version.Status, strings.Join(versionErrors, "; "))
+	}
+
 	permissions, err := findThemePermissionsByTwoPartKey(ctx, conn, awsAccountID, themeID)
 
 	if err != nil {
@@ -212,7 +275,7 @@ func resourceThemeUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "version_retention_count") {
 		input := &quicksight.UpdateThemeInput{
 			AwsAccountId: aws.String(awsAccountID),
 			BaseThemeId:  aws.String(d.Get("base_theme_id").(string)),
@@ -235,6 +298,12 @@ func resourceThemeUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if v, ok := d.GetOk("version_retention_count"); ok {
+		if err := pruneThemeVersions(ctx, conn, awsAccountID, themeID, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning QuickSight Theme (%s) versions: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrPermissions) {
 		o, n := d.GetChange(names.AttrPermissions)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
@@ -385,10 +454,16 @@ func statusTheme(ctx context.Context, conn *quicksight.Client, awsAccountID, the
 }
 
 func waitThemeCreated(ctx context.Context, conn *quicksight.Client, awsAccountID, themeID string, timeout time.Duration) (*awstypes.Theme, error) {
+	return waitThemeVersionCreated(ctx, statusTheme(ctx, conn, awsAccountID, themeID), timeout)
+}
+
+// waitThemeVersionCreated waits for a theme's first version to be created.
+// CREATION_FAILED is terminal and the version errors are returned.
+func waitThemeVersionCreated(ctx context.Context, refresh retry.StateRefreshFunc, timeout time.Duration) (*awstypes.Theme, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusCreationSuccessful),
-		Refresh: statusTheme(ctx, conn, awsAccountID, themeID),
+		Refresh: refresh,
 		Timeout: timeout,
 	}
 
@@ -426,6 +501,23 @@ func waitThemeUpdated(ctx context.Context, conn *quicksight.Client, awsAccountID
 	return nil, err
 }
 
+// themeVersionErrors returns a description of each of a failed theme version's errors.
+func themeVersionErrors(apiObject *awstypes.ThemeVersion) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch apiObject.Status {
+	case awstypes.ResourceStatusCreationFailed, awstypes.ResourceStatusUpdateFailed:
+	default:
+		return nil
+	}
+
+	return tfslices.ApplyToAll(apiObject.Errors, func(v awstypes.ThemeError) string {
+		return fmt.Sprintf("%s: %s", v.Type, aws.ToString(v.Message))
+	})
+}
+
 func themeError(apiObjects []awstypes.ThemeError) error {
 	errs := tfslices.ApplyToAll(apiObjects, func(v awstypes.ThemeError) error {
 		return fmt.Errorf("%s: %s", v.Type, aws.ToString(v.Message))
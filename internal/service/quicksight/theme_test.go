@@ -6,11 +6,18 @@ package quicksight_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,6 +26,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestWaitThemeVersionCreated(t *testing.T) {
+	t.Parallel()
+
+	themeErrors := []awstypes.ThemeError{
+		{
+			Message: aws.String("Invalid color palette"),
+			Type:    awstypes.ThemeErrorTypeInternalFailure,
+		},
+	}
+
+	testCases := map[string]struct {
+		statuses      []awstypes.ResourceStatus
+		expectedError *regexp.Regexp
+	}{
+		"created": {
+			statuses: []awstypes.ResourceStatus{awstypes.ResourceStatusCreationInProgress, awstypes.ResourceStatusCreationSuccessful},
+		},
+		"creation failed": {
+			statuses:      []awstypes.ResourceStatus{awstypes.ResourceStatusCreationInProgress, awstypes.ResourceStatusCreationFailed},
+			expectedError: regexache.MustCompile(`unexpected state 'CREATION_FAILED'.*INTERNAL_FAILURE: Invalid color palette`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var polls int
+			refresh := func() (interface{}, string, error) {
+				// Keep reporting the last status so that a non-terminal failure would wait for the timeout.
+				status := testCase.statuses[min(polls, len(testCase.statuses)-1)]
+				polls++
+
+				output := &awstypes.Theme{Version: &awstypes.ThemeVersion{Status: status}}
+				if status == awstypes.ResourceStatusCreationFailed {
+					output.Version.Errors = themeErrors
+				}
+
+				return output, string(status), nil
+			}
+
+			output, err := tfquicksight.WaitThemeVersionCreated(ctx, refresh, 1*time.Minute)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedError)
+				}
+
+				if !testCase.expectedError.MatchString(err.Error()) {
+					t.Errorf("error = %q, want match for %q", err, testCase.expectedError)
+				}
+			}
+
+			if got, want := output.Version.Status, testCase.statuses[len(testCase.statuses)-1]; got != want {
+				t.Errorf("status = %s, want %s", got, want)
+			}
+
+			if got, want := polls, len(testCase.statuses); got != want {
+				t.Errorf("polls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestThemeVersionErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		version  *awstypes.ThemeVersion
+		expected []string
+	}{
+		"nil": {},
+		"successful": {
+			version: &awstypes.ThemeVersion{
+				Status: awstypes.ResourceStatusCreationSuccessful,
+			},
+		},
+		"creation failed": {
+			version: &awstypes.ThemeVersion{
+				Errors: []awstypes.ThemeError{
+					{
+						Message: aws.String("Invalid color palette"),
+						Type:    awstypes.ThemeErrorTypeInternalFailure,
+					},
+				},
+				Status: awstypes.ResourceStatusCreationFailed,
+			},
+			expected: []string{
+				"INTERNAL_FAILURE: Invalid color palette",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfquicksight.ThemeVersionErrors(testCase.version), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("ThemeVersionErrors = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccQuickSightTheme_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var theme awstypes.Theme
@@ -103,6 +220,9 @@ func TestAccQuickSightTheme_fullConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "theme_id", rId),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceStatusCreationSuccessful)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.typography.0.font_families.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.typography.0.font_families.0.font_family", "monospace"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.typography.0.font_families.1.font_family", "Roboto"),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.ui_color_palette.0.measure_foreground", "#FFFFFF"),
 				),
 			},
@@ -158,6 +278,122 @@ func TestAccQuickSightTheme_update(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightTheme_versionNumberOutOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var theme awstypes.Theme
+	resourceName := "aws_quicksight_theme.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	themeId := "MIDNIGHT"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThemeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThemeConfig_basic(rId, rName, themeId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThemeExists(ctx, resourceName, &theme),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+					testAccCheckThemeCreateVersion(ctx, &theme),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccThemeConfig_basic(rId, rName, themeId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTheme_permissionsOutOfBandGrant(t *testing.T) {
+	ctx := acctest.Context(t)
+	var theme awstypes.Theme
+	resourceName := "aws_quicksight_theme.test"
+	groupResourceName := "aws_quicksight_group.test"
+	userResourceName := "aws_quicksight_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	themeId := "MIDNIGHT"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThemeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThemeConfig_permissions(rId, rName, themeId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThemeExists(ctx, resourceName, &theme),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permissions.*.principal", userResourceName, names.AttrARN),
+					testAccCheckThemeGrantPermissions(ctx, &theme, groupResourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permissions.*.principal", groupResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccThemeConfig_permissions(rId, rName, themeId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permissions.*.principal", userResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTheme_baseThemeIDUnknown(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThemeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccThemeConfig_basic(rId, rName, rId+"-missing"),
+				ExpectError: regexache.MustCompile(`base_theme_id \(` + rId + `-missing\) must be one of the QuickSight starting themes`),
+			},
+		},
+	})
+}
+
 func testAccCheckThemeDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
@@ -205,6 +441,56 @@ func testAccCheckThemeExists(ctx context.Context, n string, v *awstypes.Theme) r
 	}
 }
 
+// testAccCheckThemeCreateVersion creates a new version of the theme outside of Terraform with the same content as the latest version.
+func testAccCheckThemeCreateVersion(ctx context.Context, v *awstypes.Theme) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		awsAccountID := acctest.Provider.Meta().(*conns.AWSClient).AccountID
+		themeID := aws.ToString(v.ThemeId)
+		input := &quicksight.UpdateThemeInput{
+			AwsAccountId:  aws.String(awsAccountID),
+			BaseThemeId:   v.Version.BaseThemeId,
+			Configuration: v.Version.Configuration,
+			Name:          v.Name,
+			ThemeId:       aws.String(themeID),
+		}
+
+		if _, err := conn.UpdateTheme(ctx, input); err != nil {
+			return err
+		}
+
+		_, err := tfquicksight.WaitThemeUpdated(ctx, conn, awsAccountID, themeID, 5*time.Minute)
+
+		return err
+	}
+}
+
+// testAccCheckThemeGrantPermissions grants the principal of resource n access to the theme outside of Terraform.
+func testAccCheckThemeGrantPermissions(ctx context.Context, v *awstypes.Theme, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := conn.UpdateThemePermissions(ctx, &quicksight.UpdateThemePermissionsInput{
+			AwsAccountId: aws.String(acctest.Provider.Meta().(*conns.AWSClient).AccountID),
+			GrantPermissions: []awstypes.ResourcePermission{
+				{
+					Actions:   []string{"quicksight:DescribeTheme"},
+					Principal: aws.String(rs.Primary.Attributes[names.AttrARN]),
+				},
+			},
+			ThemeId: v.ThemeId,
+		})
+
+		return err
+	}
+}
+
 func testAccThemeConfig_basic(rId, rName, baseThemId string) string {
 	return acctest.ConfigCompose(
 		fmt.Sprintf(`
@@ -347,3 +633,52 @@ resource "aws_quicksight_theme" "test" {
 }
 `, rId, rName, baseThemId, emptyFillColor))
 }
+
+func testAccThemeConfig_permissions(rId, rName, baseThemId string) string {
+	return acctest.ConfigCompose(
+		testAccDataSource_UserConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_group" "test" {
+  group_name = %[2]q
+}
+
+resource "aws_quicksight_theme" "test" {
+  theme_id = %[1]q
+  name     = %[2]q
+
+  base_theme_id = %[3]q
+
+  configuration {
+    data_color_palette {
+      colors = [
+        "#FFFFFF",
+        "#111111",
+        "#222222",
+        "#333333",
+        "#444444",
+        "#555555",
+        "#666666",
+        "#777777",
+        "#888888",
+        "#999999"
+      ]
+      empty_fill_color = "#FFFFFF"
+      min_max_gradient = [
+        "#FFFFFF",
+        "#111111",
+      ]
+    }
+  }
+
+  permissions {
+    actions = [
+      "quicksight:DescribeTheme",
+      "quicksight:DescribeThemeAlias",
+      "quicksight:ListThemeAliases",
+      "quicksight:ListThemeVersions",
+    ]
+    principal = aws_quicksight_user.test.arn
+  }
+}
+`, rId, rName, baseThemId))
+}
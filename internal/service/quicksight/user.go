@@ -52,7 +52,6 @@ func resourceUser() *schema.Resource {
 				names.AttrEmail: {
 					Type:     schema.TypeString,
 					Required: true,
-					ForceNew: true,
 				},
 				"iam_arn": {
 					Type:     schema.TypeString,
@@ -79,6 +78,10 @@ func resourceUser() *schema.Resource {
 						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]*$`), "must contain only alphanumeric characters, hyphens, underscores, and periods"),
 					),
 				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"session_name": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -141,7 +144,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	output, err := conn.RegisterUser(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "registering QuickSight User (%s): %s", email, err)
+		return sdkdiag.AppendErrorf(diags, "registering QuickSight User (%s): %s", email, namespaceNotFoundError(err, namespace))
 	}
 
 	d.SetId(userCreateResourceID(awsAccountID, namespace, aws.ToString(output.User.UserName)))
@@ -174,6 +177,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set(names.AttrAWSAccountID, awsAccountID)
 	d.Set(names.AttrEmail, user.Email)
 	d.Set(names.AttrNamespace, namespace)
+	d.Set("principal_id", user.PrincipalId)
 	d.Set("user_role", user.Role)
 	d.Set(names.AttrUserName, user.UserName)
 
@@ -268,6 +272,7 @@ func findUser(ctx context.Context, conn *quicksight.Client, input *quicksight.De
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
+			Message:     namespacedResourceNotFoundMessage(err, aws.ToString(input.Namespace), "User", aws.ToString(input.UserName)),
 		}
 	}
 
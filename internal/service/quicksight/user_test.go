@@ -9,10 +9,15 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -54,6 +59,47 @@ func TestAccQuickSightUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightUser_principalID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user awstypes.User
+	rName := "tfacctest" + sdkacctest.RandString(10)
+	resourceName := "aws_quicksight_user." + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_id"),
+					resource.TestCheckResourceAttrWith(resourceName, "principal_id", func(value string) error {
+						if want := aws.ToString(user.PrincipalId); value != want {
+							return fmt.Errorf("principal_id = %q, want %q", value, want)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttrWith(resourceName, "principal_id", func(value string) error {
+						if want := aws.ToString(user.PrincipalId); value == "" || value != want {
+							return fmt.Errorf("principal_id = %q, want %q", value, want)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightUser_withInvalidFormattedEmailStillWorks(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user awstypes.User
@@ -137,6 +183,45 @@ func TestAccQuickSightUser_disappears(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightUser_emailDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user awstypes.User
+	rName := "tfacctest" + sdkacctest.RandString(10)
+	resourceName := "aws_quicksight_user." + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, acctest.DefaultEmailAddress),
+					testAccCheckUserUpdateEmail(ctx, resourceName, "drift-"+acctest.DefaultEmailAddress),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccUserConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrEmail), knownvalue.StringExact(acctest.DefaultEmailAddress)),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, acctest.DefaultEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "user_role", string(awstypes.UserRoleReader)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckUserExists(ctx context.Context, n string, v *awstypes.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -158,6 +243,28 @@ func testAccCheckUserExists(ctx context.Context, n string, v *awstypes.User) res
 	}
 }
 
+// testAccCheckUserUpdateEmail changes the user's email outside of Terraform, keeping its role.
+func testAccCheckUserUpdateEmail(ctx context.Context, n, email string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := conn.UpdateUser(ctx, &quicksight.UpdateUserInput{
+			AwsAccountId: aws.String(rs.Primary.Attributes[names.AttrAWSAccountID]),
+			Email:        aws.String(email),
+			Namespace:    aws.String(rs.Primary.Attributes[names.AttrNamespace]),
+			Role:         awstypes.UserRole(rs.Primary.Attributes["user_role"]),
+			UserName:     aws.String(rs.Primary.Attributes[names.AttrUserName]),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckUserDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_quicksight_users", name="Users")
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUsersRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrAWSAccountID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				names.AttrNamespace: {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  defaultUserNamespace,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 63),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]*$`), "must contain only alphanumeric characters, hyphens, underscores, and periods"),
+					),
+				},
+				"users": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"active": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							names.AttrARN: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrEmail: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"identity_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"principal_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrUserName: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"user_role": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+	namespace := d.Get(names.AttrNamespace).(string)
+	id := namespaceCreateResourceID(awsAccountID, namespace)
+	input := &quicksight.ListUsersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	}
+
+	users, err := findUsers(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight Users (%s): %s", id, namespaceNotFoundError(err, namespace))
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrAWSAccountID, awsAccountID)
+	d.Set(names.AttrNamespace, namespace)
+	if err := d.Set("users", flattenUsers(users)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting users: %s", err)
+	}
+
+	return diags
+}
+
+func findUsers(ctx context.Context, conn *quicksight.Client, input *quicksight.ListUsersInput) ([]awstypes.User, error) {
+	var output []awstypes.User
+
+	err := listPages(ctx, quicksight.NewListUsersPaginator(conn, input), func(page *quicksight.ListUsersOutput) bool {
+		output = append(output, page.UserList...)
+
+		return true
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// flattenUsers returns the attributes needed to adopt existing users as aws_quicksight_user resources.
+// External login and custom permission details are deliberately not exposed.
+func flattenUsers(apiObjects []awstypes.User) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"active":           apiObject.Active,
+			names.AttrARN:      aws.ToString(apiObject.Arn),
+			names.AttrEmail:    aws.ToString(apiObject.Email),
+			"identity_type":    apiObject.IdentityType,
+			"principal_id":     aws.ToString(apiObject.PrincipalId),
+			names.AttrUserName: aws.ToString(apiObject.UserName),
+			"user_role":        apiObject.Role,
+		})
+	}
+
+	return tfList
+}
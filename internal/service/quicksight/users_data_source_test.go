@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightUsersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_user." + rName
+	dataSourceName := "data.aws_quicksight_users.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrNamespace, tfquicksight.DefaultUserNamespace),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "users.*", map[string]string{
+						names.AttrUserName: rName,
+						names.AttrEmail:    acctest.DefaultEmailAddress,
+						"identity_type":    "QUICKSIGHT",
+						"user_role":        "READER",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "users.*.arn", resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccUserConfig_basic(rName),
+		fmt.Sprintf(`
+data "aws_quicksight_users" "test" {
+  depends_on = [aws_quicksight_user.%[1]s]
+}
+`, rName))
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// validationStrategySchema returns the schema for the validation mode used when an analysis or dashboard definition is
+// created or updated. The mode isn't returned by the Describe APIs, so it's only kept in state.
+func validationStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: enum.Validate[awstypes.ValidationStrategyMode](),
+	}
+}
+
+func expandValidationStrategy(d *schema.ResourceData) *awstypes.ValidationStrategy {
+	v, ok := d.Get("validation_strategy").(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	return &awstypes.ValidationStrategy{
+		Mode: awstypes.ValidationStrategyMode(v),
+	}
+}
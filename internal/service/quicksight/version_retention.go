@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func versionRetentionCountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// versionsToPrune returns the version numbers that should be deleted so that only the keep most recent versions remain.
+// Protected versions (for example, those referenced by an alias) are never pruned and don't count towards keep.
+// The returned version numbers are in ascending order.
+func versionsToPrune(versions, protected []int64, keep int) []int64 {
+	versions = slices.Clone(versions)
+	slices.Sort(versions)
+	slices.Reverse(versions)
+
+	var prune []int64
+	var kept int
+	for _, v := range versions {
+		if slices.Contains(protected, v) {
+			continue
+		}
+
+		if kept < keep {
+			kept++
+			continue
+		}
+
+		prune = append(prune, v)
+	}
+	slices.Sort(prune)
+
+	return prune
+}
+
+func pruneTemplateVersions(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string, keep int) error {
+	versions, err := findTemplateVersionNumbers(ctx, conn, awsAccountID, templateID)
+
+	if err != nil {
+		return fmt.Errorf("listing versions: %w", err)
+	}
+
+	protected, err := findTemplateAliasVersionNumbers(ctx, conn, awsAccountID, templateID)
+
+	if err != nil {
+		return fmt.Errorf("listing aliases: %w", err)
+	}
+
+	for _, v := range versionsToPrune(versions, protected, keep) {
+		_, err := conn.DeleteTemplate(ctx, &quicksight.DeleteTemplateInput{
+			AwsAccountId:  aws.String(awsAccountID),
+			TemplateId:    aws.String(templateID),
+			VersionNumber: aws.Int64(v),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting version (%d): %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+func findTemplateVersionNumbers(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string) ([]int64, error) {
+	input := &quicksight.ListTemplateVersionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	}
+	var output []int64
+
+	err := listPages(ctx, quicksight.NewListTemplateVersionsPaginator(conn, input), func(page *quicksight.ListTemplateVersionsOutput) bool {
+		for _, v := range page.TemplateVersionSummaryList {
+			output = append(output, aws.ToInt64(v.VersionNumber))
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findTemplateAliasVersionNumbers(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string) ([]int64, error) {
+	input := &quicksight.ListTemplateAliasesInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	}
+	var output []int64
+
+	err := listPages(ctx, quicksight.NewListTemplateAliasesPaginator(conn, input), func(page *quicksight.ListTemplateAliasesOutput) bool {
+		for _, v := range page.TemplateAliasList {
+			output = append(output, aws.ToInt64(v.TemplateVersionNumber))
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func pruneThemeVersions(ctx context.Context, conn *quicksight.Client, awsAccountID, themeID string, keep int) error {
+	versions, err := findThemeVersionNumbers(ctx, conn, awsAccountID, themeID)
+
+	if err != nil {
+		return fmt.Errorf("listing versions: %w", err)
+	}
+
+	protected, err := findThemeAliasVersionNumbers(ctx, conn, awsAccountID, themeID)
+
+	if err != nil {
+		return fmt.Errorf("listing aliases: %w", err)
+	}
+
+	for _, v := range versionsToPrune(versions, protected, keep) {
+		_, err := conn.DeleteTheme(ctx, &quicksight.DeleteThemeInput{
+			AwsAccountId:  aws.String(awsAccountID),
+			ThemeId:       aws.String(themeID),
+			VersionNumber: aws.Int64(v),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting version (%d): %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+func findThemeVersionNumbers(ctx context.Context, conn *quicksight.Client, awsAccountID, themeID string) ([]int64, error) {
+	input := &quicksight.ListThemeVersionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	}
+	var output []int64
+
+	err := listPages(ctx, quicksight.NewListThemeVersionsPaginator(conn, input), func(page *quicksight.ListThemeVersionsOutput) bool {
+		for _, v := range page.ThemeVersionSummaryList {
+			output = append(output, aws.ToInt64(v.VersionNumber))
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findThemeAliasVersionNumbers(ctx context.Context, conn *quicksight.Client, awsAccountID, themeID string) ([]int64, error) {
+	input := &quicksight.ListThemeAliasesInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	}
+	var output []int64
+
+	err := listPages(ctx, &listThemeAliasesPaginator{conn: conn, input: input, firstPage: true}, func(page *quicksight.ListThemeAliasesOutput) bool {
+		for _, v := range page.ThemeAliasList {
+			output = append(output, aws.ToInt64(v.ThemeVersionNumber))
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// listThemeAliasesPaginator pages through ListThemeAliases, which has no SDK paginator. Like the SDK paginators,
+// it only advances its pagination token on success.
+type listThemeAliasesPaginator struct {
+	conn      *quicksight.Client
+	input     *quicksight.ListThemeAliasesInput
+	firstPage bool
+}
+
+func (p *listThemeAliasesPaginator) HasMorePages() bool {
+	return p.firstPage || aws.ToString(p.input.NextToken) != ""
+}
+
+func (p *listThemeAliasesPaginator) NextPage(ctx context.Context, optFns ...func(*quicksight.Options)) (*quicksight.ListThemeAliasesOutput, error) {
+	output, err := p.conn.ListThemeAliases(ctx, p.input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.firstPage = false
+	p.input.NextToken = output.NextToken
+
+	return output, nil
+}
+
+func pruneDashboardVersions(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string, keep int) error {
+	versions, err := findDashboardVersionNumbers(ctx, conn, awsAccountID, dashboardID)
+
+	if err != nil {
+		return fmt.Errorf("listing versions: %w", err)
+	}
+
+	// The published version may have been changed outside of Terraform, so it's protected like an alias version.
+	published, err := findDashboardPublishedVersionNumber(ctx, conn, awsAccountID, dashboardID)
+
+	if err != nil {
+		return fmt.Errorf("reading published version: %w", err)
+	}
+
+	for _, v := range versionsToPrune(versions, []int64{published}, keep) {
+		if err := deleteDashboardVersion(ctx, conn, awsAccountID, dashboardID, v); err != nil {
+			return fmt.Errorf("deleting version (%d): %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteDashboardVersions deletes the specified dashboard versions. Versions that no longer exist are skipped.
+// The published version can't be deleted without deleting the dashboard, so it's rejected.
+func deleteDashboardVersions(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string, versions []int64) error {
+	published, err := findDashboardPublishedVersionNumber(ctx, conn, awsAccountID, dashboardID)
+
+	if err != nil {
+		return fmt.Errorf("reading published version: %w", err)
+	}
+
+	if slices.Contains(versions, published) {
+		return fmt.Errorf("version (%d) is the published version and can't be deleted", published)
+	}
+
+	for _, v := range versions {
+		if err := deleteDashboardVersion(ctx, conn, awsAccountID, dashboardID, v); err != nil {
+			return fmt.Errorf("deleting version (%d): %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+// findDashboardPublishedVersionNumber returns the number of the dashboard's published version.
+// DescribeDashboard without a version number describes the published version.
+func findDashboardPublishedVersionNumber(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string) (int64, error) {
+	dashboard, err := findDashboardByThreePartKey(ctx, conn, awsAccountID, dashboardID, dashboardLatestVersion)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return aws.ToInt64(dashboard.Version.VersionNumber), nil
+}
+
+// deleteDashboardVersion deletes a single dashboard version, leaving the dashboard and its other versions in place.
+// Omitting the version number from DeleteDashboard deletes the whole dashboard.
+func deleteDashboardVersion(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string, version int64) error {
+	_, err := conn.DeleteDashboard(ctx, &quicksight.DeleteDashboardInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		DashboardId:   aws.String(dashboardID),
+		VersionNumber: aws.Int64(version),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findDashboardVersionNumbers(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string) ([]int64, error) {
+	input := &quicksight.ListDashboardVersionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	}
+	var output []int64
+
+	err := listPages(ctx, quicksight.NewListDashboardVersionsPaginator(conn, input), func(page *quicksight.ListDashboardVersionsOutput) bool {
+		for _, v := range page.DashboardVersionSummaryList {
+			output = append(output, aws.ToInt64(v.VersionNumber))
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
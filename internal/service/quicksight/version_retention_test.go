@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+)
+
+func TestVersionsToPrune(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versions  []int64
+		protected []int64
+		keep      int
+		expected  []int64
+	}{
+		"no versions": {
+			keep: 1,
+		},
+		"fewer than keep": {
+			versions: []int64{1, 2},
+			keep:     3,
+		},
+		"prune oldest": {
+			versions: []int64{1, 2, 3, 4},
+			keep:     2,
+			expected: []int64{1, 2},
+		},
+		"unordered": {
+			versions: []int64{3, 1, 4, 2},
+			keep:     1,
+			expected: []int64{1, 2, 3},
+		},
+		"alias protected": {
+			versions:  []int64{1, 2, 3, 4},
+			protected: []int64{1},
+			keep:      1,
+			expected:  []int64{2, 3},
+		},
+		"alias protected latest": {
+			versions:  []int64{1, 2, 3, 4},
+			protected: []int64{4, 2},
+			keep:      1,
+			expected:  []int64{1},
+		},
+		"all protected": {
+			versions:  []int64{1, 2, 3},
+			protected: []int64{1, 2, 3},
+			keep:      1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfquicksight.VersionsToPrune(testCase.versions, testCase.protected, testCase.keep)
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("VersionsToPrune = %v, want %v", got, testCase.expected)
+			}
+
+			for _, v := range testCase.protected {
+				if slices.Contains(got, v) {
+					t.Errorf("VersionsToPrune pruned alias-protected version %d", v)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteDashboardVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err         error
+		expectError bool
+	}{
+		"deleted": {},
+		"not found": {
+			err: &awstypes.ResourceNotFoundException{Message: aws.String("version not found")},
+		},
+		"other error": {
+			err:         &awstypes.InvalidParameterValueException{Message: aws.String("invalid")},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var inputs []*quicksight.DeleteDashboardInput
+			conn := newTestClient(func(input any) (any, error) {
+				v, ok := input.(*quicksight.DeleteDashboardInput)
+				if !ok {
+					return nil, fmt.Errorf("unexpected operation input: %T", input)
+				}
+				inputs = append(inputs, v)
+
+				return &quicksight.DeleteDashboardOutput{}, testCase.err
+			})
+
+			err := tfquicksight.DeleteDashboardVersion(ctx, conn, "123456789012", "example", 2)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(inputs) != 1 {
+				t.Fatalf("DeleteDashboard called %d times, want 1", len(inputs))
+			}
+
+			if got, want := aws.ToString(inputs[0].DashboardId), "example"; got != want {
+				t.Errorf("DashboardId = %q, want %q", got, want)
+			}
+
+			// A missing version number would delete the whole dashboard.
+			if inputs[0].VersionNumber == nil {
+				t.Fatal("VersionNumber not set")
+			}
+
+			if got, want := aws.ToInt64(inputs[0].VersionNumber), int64(2); got != want {
+				t.Errorf("VersionNumber = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -7,16 +7,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -29,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -126,10 +131,11 @@ func (r *vpcConnectionResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:    true,
 				ElementType: types.StringType,
 				Validators: []validator.Set{
-					setvalidator.SizeBetween(1, 15),
+					setvalidator.SizeBetween(1, vpcConnectionMaxDNSResolvers),
 					setvalidator.ValueStringsAre(
 						stringvalidator.All(
 							stringvalidator.LengthBetween(7, 15),
+							fwvalidators.IPv4Address(),
 						),
 					),
 				},
@@ -385,6 +391,89 @@ func (r *vpcConnectionResource) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *vpcConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
+
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceVPCConnectionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resourceVPCConnectionData
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.SubnetIds.Equal(state.SubnetIds) {
+			return
+		}
+	}
+
+	// Subnet IDs may not be known until apply.
+	if plan.SubnetIds.IsNull() || plan.SubnetIds.IsUnknown() {
+		return
+	}
+	for _, v := range plan.SubnetIds.Elements() {
+		if v.IsUnknown() {
+			return
+		}
+	}
+	subnetIDs := flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
+
+	availabilityZones, err := findSubnetAvailabilityZones(ctx, r.Meta().EC2Client(ctx), subnetIDs)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(names.AttrSubnetIDs),
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCheckingExistence, resNameVPCConnection, plan.VPCConnectionID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if n := len(availabilityZones); n < vpcConnectionMinAvailabilityZones {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(names.AttrSubnetIDs),
+			"Invalid Attribute Value",
+			fmt.Sprintf("subnet_ids must span at least %d Availability Zones, got %d (%s)", vpcConnectionMinAvailabilityZones, n, strings.Join(availabilityZones, ", ")),
+		)
+	}
+}
+
+const (
+	// CreateVPCConnection accepts at most 15 DNS resolver IP addresses.
+	vpcConnectionMaxDNSResolvers = 15
+	// CreateVPCConnection requires subnets in at least two Availability Zones.
+	vpcConnectionMinAvailabilityZones = 2
+)
+
+// findSubnetAvailabilityZones returns the sorted, distinct Availability Zones of the specified subnets.
+func findSubnetAvailabilityZones(ctx context.Context, conn *ec2.Client, subnetIDs []string) ([]string, error) {
+	input := &ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	}
+
+	subnets, err := tfec2.FindSubnets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var availabilityZones []string
+	for _, v := range subnets {
+		if az := aws.ToString(v.AvailabilityZone); !slices.Contains(availabilityZones, az) {
+			availabilityZones = append(availabilityZones, az)
+		}
+	}
+	slices.Sort(availabilityZones)
+
+	return availabilityZones, nil
 }
 
 func findVPCConnectionByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, vpcConnectionID string) (*awstypes.VPCConnection, error) {
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_quicksight_vpc_connection", name="VPC Connection")
+func dataSourceVPCConnection() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCConnectionRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"availability_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrAWSAccountID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"dns_resolvers": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrRoleARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrSecurityGroupIDs: {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrStatus: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrSubnetIDs: {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"vpc_connection_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceVPCConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+	vpcConnectionID := d.Get("vpc_connection_id").(string)
+	id := vpcConnectionCreateResourceID(awsAccountID, vpcConnectionID)
+
+	vpcConnection, err := findVPCConnectionByTwoPartKey(ctx, conn, awsAccountID, vpcConnectionID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight VPC Connection (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, vpcConnection.Arn)
+	d.Set("availability_status", vpcConnection.AvailabilityStatus)
+	d.Set(names.AttrAWSAccountID, awsAccountID)
+	d.Set("dns_resolvers", vpcConnection.DnsResolvers)
+	d.Set(names.AttrName, vpcConnection.Name)
+	d.Set(names.AttrRoleARN, vpcConnection.RoleArn)
+	d.Set(names.AttrSecurityGroupIDs, vpcConnection.SecurityGroupIds)
+	d.Set(names.AttrStatus, vpcConnection.Status)
+	var subnetIDs []string
+	for _, v := range vpcConnection.NetworkInterfaces {
+		subnetIDs = append(subnetIDs, aws.ToString(v.SubnetId))
+	}
+	d.Set(names.AttrSubnetIDs, subnetIDs)
+	d.Set("vpc_connection_id", vpcConnection.VPCConnectionId)
+
+	return diags
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -77,6 +78,87 @@ func TestAccQuickSightVPCConnection_disappears(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightVPCConnection_subnetsSingleAvailabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Create the subnets first so that their IDs are known at plan time.
+			{
+				Config: testAccVPCConnectionConfig_baseSingleAvailabilityZone(rName),
+			},
+			{
+				Config:      testAccVPCConnectionConfig_subnetsSingleAvailabilityZone(rId, rName),
+				ExpectError: regexache.MustCompile(`subnet_ids must span at least 2 Availability Zones, got 1`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightVPCConnection_dnsResolvers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpcConnection awstypes.VPCConnection
+	resourceName := "aws_quicksight_vpc_connection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionConfig_dnsResolvers(rId, rName, `["10.0.0.10", "10.0.1.10"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCConnectionExists(ctx, resourceName, &vpcConnection),
+					resource.TestCheckResourceAttr(resourceName, "dns_resolvers.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "dns_resolvers.*", "10.0.0.10"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "dns_resolvers.*", "10.0.1.10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightVPCConnection_dnsResolversInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCConnectionConfig_dnsResolvers(rId, rName, `["10.0.0.256"]`),
+				ExpectError: regexache.MustCompile(`value must be a valid IPv4 address`),
+			},
+			{
+				Config:      testAccVPCConnectionConfig_dnsResolvers(rId, rName, `["not.an.ip.addr"]`),
+				ExpectError: regexache.MustCompile(`value must be a valid IPv4 address`),
+			},
+			{
+				Config:      testAccVPCConnectionConfig_dnsResolvers(rId, rName, `[for i in range(16) : "10.0.0.${i + 10}"]`),
+				ExpectError: regexache.MustCompile(`set must contain at least 1 elements and at most 15 elements`),
+			},
+		},
+	})
+}
+
 func testAccCheckVPCConnectionExists(ctx context.Context, n string, v *awstypes.VPCConnection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -167,6 +249,57 @@ resource "aws_iam_role" "test" {
 `)
 }
 
+func testAccVPCConnectionConfig_baseSingleAvailabilityZone(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBaseVPCConnectionConfig(rName),
+		fmt.Sprintf(`
+resource "aws_subnet" "single_az" {
+  count = 2
+
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index + 10)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCConnectionConfig_subnetsSingleAvailabilityZone(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCConnectionConfig_baseSingleAvailabilityZone(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_vpc_connection" "test" {
+  vpc_connection_id = %[1]q
+  name              = %[2]q
+  role_arn          = aws_iam_role.test.arn
+  security_group_ids = [
+    aws_security_group.test.id,
+  ]
+  subnet_ids = aws_subnet.single_az[*].id
+}
+`, rId, rName))
+}
+
+func testAccVPCConnectionConfig_dnsResolvers(rId, rName, dnsResolvers string) string {
+	return acctest.ConfigCompose(
+		testAccBaseVPCConnectionConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_vpc_connection" "test" {
+  vpc_connection_id = %[1]q
+  name              = %[2]q
+  role_arn          = aws_iam_role.test.arn
+  security_group_ids = [
+    aws_security_group.test.id,
+  ]
+  subnet_ids    = aws_subnet.test[*].id
+  dns_resolvers = %[3]s
+}
+`, rId, rName, dnsResolvers))
+}
+
 func testAccVPCConnectionConfig_basic(rId string, rName string) string {
 	return acctest.ConfigCompose(
 		testAccBaseVPCConnectionConfig(rName),
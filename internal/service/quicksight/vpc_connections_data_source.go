@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_quicksight_vpc_connections", name="VPC Connections")
+func dataSourceVPCConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCConnectionsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrAWSAccountID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"vpc_connections": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"availability_status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"vpc_connection_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceVPCConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightClient(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAWSAccountID); ok {
+		awsAccountID = v.(string)
+	}
+	input := &quicksight.ListVPCConnectionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	vpcConnections, err := findVPCConnections(ctx, conn, input, func(v *awstypes.VPCConnectionSummary) bool {
+		return v.Status != awstypes.VPCConnectionResourceStatusDeleted
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight VPC Connections (%s): %s", awsAccountID, err)
+	}
+
+	d.SetId(awsAccountID)
+	d.Set(names.AttrAWSAccountID, awsAccountID)
+	if err := d.Set("vpc_connections", flattenVPCConnectionSummaries(vpcConnections)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_connections: %s", err)
+	}
+
+	return diags
+}
+
+func findVPCConnections(ctx context.Context, conn *quicksight.Client, input *quicksight.ListVPCConnectionsInput, filter tfslices.Predicate[*awstypes.VPCConnectionSummary]) ([]awstypes.VPCConnectionSummary, error) {
+	var output []awstypes.VPCConnectionSummary
+
+	err := listPages(ctx, quicksight.NewListVPCConnectionsPaginator(conn, input), func(page *quicksight.ListVPCConnectionsOutput) bool {
+		for _, v := range page.VPCConnectionSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenVPCConnectionSummaries(apiObjects []awstypes.VPCConnectionSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"availability_status": apiObject.AvailabilityStatus,
+			names.AttrName:        aws.ToString(apiObject.Name),
+			"vpc_connection_id":   aws.ToString(apiObject.VPCConnectionId),
+		})
+	}
+
+	return tfList
+}
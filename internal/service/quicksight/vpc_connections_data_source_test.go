@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightVPCConnectionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_vpc_connection.test"
+	dataSourceName := "data.aws_quicksight_vpc_connections.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionsDataSourceConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrAWSAccountID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "vpc_connections.*.vpc_connection_id", resourceName, "vpc_connection_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "vpc_connections.*.name", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCConnectionsDataSourceConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCConnectionConfig_basic(rId, rName),
+		`
+data "aws_quicksight_vpc_connections" "test" {
+  depends_on = [aws_quicksight_vpc_connection.test]
+}
+`)
+}